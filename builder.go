@@ -0,0 +1,44 @@
+package semver
+
+// Builder constructs a Version step by step.
+// The first error encountered is retained, subsequent steps are skipped and Build returns it
+type Builder struct {
+	v   Version
+	err error
+}
+
+// Builder returns a Builder initialized with copy of v
+func (v Version) Builder() *Builder {
+	return &Builder{v: v}
+}
+
+// Prerelease sets dot-separated prerelease versions, empty s removes prerelease
+func (b *Builder) Prerelease(s string) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	b.v.pre, b.err = NewPrerelease(s)
+
+	return b
+}
+
+// Metadata sets dot-separated build metadata, empty s removes build metadata
+func (b *Builder) Metadata(s string) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	b.v.build, b.err = NewBuild(s)
+
+	return b
+}
+
+// Build returns constructed Version or the first error encountered
+func (b *Builder) Build() (Version, error) {
+	if b.err != nil {
+		return Version{}, b.err
+	}
+
+	return b.v, nil
+}
@@ -0,0 +1,31 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	orig := MustParse("1.2.3")
+
+	v, err := orig.Builder().Prerelease("beta.1").Metadata("build.5").Build()
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-beta.1+build.5", v.String())
+	require.Equal(t, "1.2.3", orig.String())
+
+	v, err = v.Builder().Prerelease("").Build()
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3+build.5", v.String())
+}
+
+func TestBuilderError(t *testing.T) {
+	b := MustParse("1.2.3").Builder().Prerelease("be?ta").Metadata("build.5")
+
+	_, err := b.Build()
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+
+	_, err = MustParse("1.2.3").Builder().Metadata("**").Prerelease("beta").Build()
+	require.Error(t, err)
+}
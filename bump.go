@@ -0,0 +1,76 @@
+package semver
+
+import (
+	"strings"
+)
+
+// BumpKind represents which version component is to be incremented
+type BumpKind int
+
+const (
+	// BumpPatch increment patch version
+	BumpPatch BumpKind = iota
+	// BumpMinor increment minor version
+	BumpMinor
+	// BumpMajor increment major version
+	BumpMajor
+)
+
+// BumpKindFromCommit infers bump kind from conventional commit message:
+//   - "feat!: ...", "fix(scope)!: ..." or "BREAKING CHANGE:" footer is BumpMajor
+//   - "feat: ..." is BumpMinor
+//   - anything else is BumpPatch
+func BumpKindFromCommit(msg string) BumpKind {
+	lines := strings.Split(strings.TrimSpace(msg), "\n")
+
+	for _, line := range lines[1:] {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			return BumpMajor
+		}
+	}
+
+	colon := strings.IndexByte(lines[0], ':')
+	if colon == -1 {
+		return BumpPatch
+	}
+
+	typ := strings.TrimSpace(lines[0][:colon])
+	if strings.HasSuffix(typ, "!") {
+		return BumpMajor
+	}
+
+	if i := strings.IndexByte(typ, '('); i != -1 {
+		typ = typ[:i]
+	}
+
+	if strings.EqualFold(typ, "feat") {
+		return BumpMinor
+	}
+
+	return BumpPatch
+}
+
+// SuggestBump returns next release version for given kind of changes:
+// breaking change bumps major, new feature bumps minor, anything else bumps patch.
+// For 0.x versions breaking changes bump minor as well.
+// Prerelease and build metadata are dropped from the result
+func (v Version) SuggestBump(breaking, feature bool) (Version, error) {
+	res := Version{major: v.major, minor: v.minor, patch: v.patch}
+
+	var err error
+
+	switch {
+	case breaking && v.major != 0:
+		err = res.IncrementMajor()
+	case breaking, feature:
+		err = res.IncrementMinor()
+	default:
+		err = res.IncrementPatch()
+	}
+
+	if err != nil {
+		return Version{}, err
+	}
+
+	return res, nil
+}
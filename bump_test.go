@@ -0,0 +1,55 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBumpKindFromCommit(t *testing.T) {
+	tests := []struct {
+		msg  string
+		kind BumpKind
+	}{
+		{"feat!: drop X", BumpMajor},
+		{"fix(parser)!: change error type", BumpMajor},
+		{"feat: add Y", BumpMinor},
+		{"feat(range): add Z", BumpMinor},
+		{"fix: handle empty input", BumpPatch},
+		{"chore: update deps", BumpPatch},
+		{"update readme", BumpPatch},
+		{"feat: add Y\n\nBREAKING CHANGE: X removed", BumpMajor},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.kind, BumpKindFromCommit(tc.msg), tc.msg)
+	}
+}
+
+func TestSuggestBump(t *testing.T) {
+	tests := []struct {
+		v        string
+		breaking bool
+		feature  bool
+		expected string
+	}{
+		{"1.2.3", true, true, "2.0.0"},
+		{"1.2.3", true, false, "2.0.0"},
+		{"1.2.3", false, true, "1.3.0"},
+		{"1.2.3", false, false, "1.2.4"},
+		{"1.2.3-rc.1+build", false, false, "1.2.4"},
+		{"0.2.3", true, true, "0.3.0"},
+		{"0.2.3", true, false, "0.3.0"},
+		{"0.2.3", false, true, "0.3.0"},
+		{"0.2.3", false, false, "0.2.4"},
+	}
+
+	for _, tc := range tests {
+		res, err := MustParse(tc.v).SuggestBump(tc.breaking, tc.feature)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, res.String(), "%s breaking=%v feature=%v", tc.v, tc.breaking, tc.feature)
+	}
+
+	_, err := Version{1, 2, ^uint64(0), nil, nil}.SuggestBump(false, false)
+	require.Equal(t, ErrOutOfBound, err)
+}
@@ -0,0 +1,121 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ChannelStable reported by Channel for versions without prerelease
+	ChannelStable = "stable"
+	// ChannelPrerelease reported by Channel for prereleases with unknown identifier
+	ChannelPrerelease = "prerelease"
+)
+
+var (
+	// ErrNotPrerelease returned when promoting a version without prerelease
+	ErrNotPrerelease = errors.New("semver: version is not a prerelease")
+)
+
+var defaultLadder = []string{"alpha", "beta", "rc"}
+
+var defaultChannels = map[string]string{
+	"alpha": "alpha",
+	"beta":  "beta",
+	"rc":    "rc",
+}
+
+// Channel classifies version into release channel by its first prerelease identifier.
+// Known identifiers are alpha, beta and rc, optionally followed by a number ("rc1")
+func (v Version) Channel() string {
+	return v.ChannelWith(defaultChannels)
+}
+
+// ChannelWith is like Channel but uses channels to map first prerelease identifier into channel name.
+// Identifier not found in channels as is is looked up with its trailing number stripped,
+// so numbering produced by SetPrereleaseNumbered ("beta0") maps same as "beta".
+// Returns ChannelStable if version has no prerelease and ChannelPrerelease if identifier is not in channels
+func (v Version) ChannelWith(channels map[string]string) string {
+	if len(v.pre) == 0 {
+		return ChannelStable
+	}
+
+	id := v.pre[0].String()
+	if ch, ok := channels[id]; ok {
+		return ch
+	}
+
+	if prefix, num := splitTrailingDigits(id); prefix != "" && num != "" {
+		if ch, ok := channels[prefix]; ok {
+			return ch
+		}
+	}
+
+	return ChannelPrerelease
+}
+
+// Promote advances prerelease along alpha -> beta -> rc -> stable ladder, see PromoteWith
+func (v *Version) Promote() error {
+	return v.PromoteWith(defaultLadder)
+}
+
+// PromoteWith advances prerelease to the next channel in ladder and restarts numbering:
+// with ladder [alpha, beta, rc] "1.2.3-alpha.1" becomes "1.2.3-beta.0" and "1.2.3-alpha1" becomes "1.2.3-beta0".
+// Promoting the last channel of ladder strips prerelease ("1.2.3-rc.2" becomes "1.2.3").
+// Channel is identified by the first prerelease identifier, matched as is or with its trailing number stripped
+func (v *Version) PromoteWith(ladder []string) error {
+	if len(v.pre) == 0 {
+		return ErrNotPrerelease
+	}
+
+	i, numbered := ladderIndex(v.pre[0].String(), ladder)
+	if i == -1 {
+		return fmt.Errorf("semver: channel %q not found in promotion ladder", v.pre[0].String())
+	}
+
+	if i == len(ladder)-1 {
+		v.pre = nil
+		return nil
+	}
+
+	if numbered {
+		pre, err := NewPRVersion(ladder[i+1] + "0")
+		if err != nil {
+			return err
+		}
+
+		v.pre = []PRVersion{pre}
+		return nil
+	}
+
+	next, err := NewPRVersion(ladder[i+1])
+	if err != nil {
+		return err
+	}
+
+	v.pre = []PRVersion{next, {VersionNum: 0, IsNum: true}}
+	return nil
+}
+
+// ladderIndex returns position of channel id in ladder or -1 if it is not there.
+// If id matches only with its trailing number stripped ("beta0") numbered is true
+func ladderIndex(id string, ladder []string) (int, bool) {
+	for i, rung := range ladder {
+		if rung == id {
+			return i, false
+		}
+	}
+
+	prefix, num := splitTrailingDigits(id)
+	if prefix == "" || num == "" {
+		return -1, false
+	}
+
+	for i, rung := range ladder {
+		if rung == prefix {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
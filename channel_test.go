@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannel(t *testing.T) {
+	tests := []struct {
+		v       string
+		channel string
+	}{
+		{"1.2.3", ChannelStable},
+		{"1.2.3+build", ChannelStable},
+		{"1.2.3-alpha", "alpha"},
+		{"1.2.3-beta.2", "beta"},
+		{"1.2.3-rc.1", "rc"},
+		{"1.2.3-beta0", "beta"},
+		{"1.2.3-rc12.x", "rc"},
+		{"1.2.3-rcx", ChannelPrerelease},
+		{"1.2.3-preview.1", ChannelPrerelease},
+		{"1.2.3-1", ChannelPrerelease},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.channel, MustParse(tc.v).Channel(), tc.v)
+	}
+}
+
+func TestChannelWith(t *testing.T) {
+	channels := map[string]string{
+		"nightly": "edge",
+		"rc":      "candidate",
+	}
+
+	require.Equal(t, "edge", MustParse("1.2.3-nightly.5").ChannelWith(channels))
+	require.Equal(t, "candidate", MustParse("1.2.3-rc.1").ChannelWith(channels))
+	require.Equal(t, ChannelPrerelease, MustParse("1.2.3-beta").ChannelWith(channels))
+	require.Equal(t, ChannelStable, MustParse("1.2.3").ChannelWith(channels))
+	require.Equal(t, "edge", MustParse("1.2.3-nightly20240115").ChannelWith(channels))
+
+	// exact identifier takes priority over stripped one
+	channels["nightly2"] = "edge2"
+	require.Equal(t, "edge2", MustParse("1.2.3-nightly2").ChannelWith(channels))
+
+	v := MustParse("1.2.3")
+	require.NoError(t, v.SetPrereleaseNumbered("beta"))
+	require.Equal(t, "beta", v.Channel())
+}
+
+func TestPromote(t *testing.T) {
+	tests := []struct {
+		v        string
+		expected string
+	}{
+		{"1.2.3-alpha.1", "1.2.3-beta.0"},
+		{"1.2.3-beta.4+build", "1.2.3-rc.0+build"},
+		{"1.2.3-rc.2", "1.2.3"},
+		{"1.2.3-alpha1", "1.2.3-beta0"},
+		{"1.2.3-beta0", "1.2.3-rc0"},
+		{"1.2.3-rc3", "1.2.3"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		require.NoError(t, v.Promote())
+		require.Equal(t, tc.expected, v.String())
+	}
+
+	v := MustParse("1.2.3")
+	require.Equal(t, ErrNotPrerelease, v.Promote())
+
+	v = MustParse("1.2.3-beta")
+	require.NoError(t, v.IncrementPrerelease())
+	require.Equal(t, "1.2.3-beta0", v.String())
+	require.NoError(t, v.Promote())
+	require.Equal(t, "1.2.3-rc0", v.String())
+
+	v = MustParse("1.2.3-preview.1")
+	require.Error(t, v.Promote())
+	require.Equal(t, "1.2.3-preview.1", v.String())
+}
+
+func TestPromoteWith(t *testing.T) {
+	ladder := []string{"nightly", "preview"}
+
+	v := MustParse("1.2.3-nightly.7")
+	require.NoError(t, v.PromoteWith(ladder))
+	require.Equal(t, "1.2.3-preview.0", v.String())
+	require.NoError(t, v.PromoteWith(ladder))
+	require.Equal(t, "1.2.3", v.String())
+}
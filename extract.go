@@ -0,0 +1,190 @@
+package semver
+
+import (
+	"strings"
+)
+
+// filenameWords are words which end prerelease and build metadata of extracted version:
+// operating systems, architectures and file extensions common in artifact names
+var filenameWords = map[string]bool{
+	"linux": true, "darwin": true, "macos": true, "osx": true, "windows": true, "win": true,
+	"win32": true, "win64": true, "freebsd": true, "netbsd": true, "openbsd": true, "android": true, "ios": true,
+	"amd64": true, "arm64": true, "arm": true, "armv6": true, "armv7": true, "aarch64": true, "386": true,
+	"i386": true, "i686": true, "x86": true, "x64": true, "ppc64le": true, "s390x": true, "riscv64": true,
+	"universal": true, "tar": true, "gz": true, "tgz": true, "zip": true, "xz": true, "bz2": true, "zst": true,
+	"jar": true, "war": true, "so": true, "dll": true, "exe": true, "msi": true, "dmg": true, "deb": true,
+	"rpm": true, "apk": true, "whl": true, "pkg": true,
+}
+
+// ExtractVersion scans s and returns the first valid version embedded in it,
+// e.g. "1.2.3" from "myapp-1.2.3.tar.gz" or "libfoo.so.1.2.3".
+// A candidate starts at a digit which does not continue a number ("12", "1.2") and must have a valid
+// Major.Minor.Patch core. Prerelease and build metadata are then extended identifier by identifier
+// as long as they stay valid, so the match is leftmost-longest.
+//
+// By the spec any hyphenated suffix is prerelease, which makes artifact names ambiguous.
+// To keep matches useful, prerelease and build metadata end before the first hyphen or dot
+// separated word naming a common operating system, architecture or file extension:
+// "release-1.2.3-linux" yields "1.2.3", "myapp-1.2.3-rc.1-linux-amd64.tar.gz" yields "1.2.3-rc.1".
+// As a consequence such words are never extracted as prerelease, e.g. "1.2.3-arm" yields "1.2.3"
+func ExtractVersion(s string) (Version, bool) {
+	v, _, ok := extractVersion(s, 0)
+	return v, ok
+}
+
+// ExtractAllVersions scans s and returns every version embedded in it in order of appearance,
+// using the same matching rules as ExtractVersion. Matches do not overlap, scanning resumes
+// after the end of previous match. Duplicates are not removed
+func ExtractAllVersions(s string) Versions {
+	var res Versions
+
+	for i := 0; i < len(s); {
+		v, end, ok := extractVersion(s, i)
+		if !ok {
+			break
+		}
+
+		res = append(res, v)
+		i = end
+	}
+
+	return res
+}
+
+// extractVersion looks for leftmost-longest version in s starting at offset from
+// and returns it along with offset of the end of match
+func extractVersion(s string, from int) (Version, int, bool) {
+	for i := from; i < len(s); i++ {
+		if !isDigit(s[i]) || continuesNumber(s, i) {
+			continue
+		}
+
+		end, ok := scanCore(s, i)
+		if !ok {
+			continue
+		}
+
+		if end < len(s) && s[end] == '-' {
+			end = scanIdentifiers(s, end, true)
+		}
+
+		if end < len(s) && s[end] == '+' {
+			end = scanIdentifiers(s, end, false)
+		}
+
+		if v, err := Parse(s[i:end]); err == nil {
+			return v, end, true
+		}
+	}
+
+	return Version{}, len(s), false
+}
+
+// scanCore matches Major.Minor.Patch at offset i of s and returns offset of its end.
+// Patch with leading zero only matches "0", e.g. "1.2.0" in "1.2.03", as its longest valid prefix
+func scanCore(s string, i int) (int, bool) {
+	for part := 0; part < 3; part++ {
+		if part > 0 {
+			if i >= len(s) || s[i] != '.' {
+				return 0, false
+			}
+			i++
+		}
+
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+
+		switch {
+		case i == start:
+			return 0, false
+		case s[start] == '0' && i-start > 1:
+			if part < 2 {
+				return 0, false
+			}
+			i = start + 1
+		}
+	}
+
+	return i, true
+}
+
+// scanIdentifiers matches dot separated identifiers following separator at offset i of s
+// and returns offset of the end of last valid one, or i if there is none.
+// Identifiers are cut before the first hyphen separated word from filenameWords.
+// Prerelease identifiers are additionally checked for numeric validity
+func scanIdentifiers(s string, i int, prerelease bool) int {
+	end := i
+
+	for {
+		start := i + 1
+		j := start
+		for j < len(s) && isIdentifierChar(s[j]) {
+			j++
+		}
+
+		cut := cutFilenameWord(s, start, j)
+		if cut == start {
+			return end
+		}
+
+		if prerelease {
+			if _, err := NewPRVersion(s[start:cut]); err != nil {
+				return end
+			}
+		}
+
+		end = cut
+		if cut != j || j == len(s) || s[j] != '.' {
+			return end
+		}
+
+		i = j
+	}
+}
+
+// cutFilenameWord returns offset of hyphen before the first word from filenameWords
+// in identifier s[start:end], start if identifier begins with such word or end if there is none
+func cutFilenameWord(s string, start, end int) int {
+	w := start
+	for k := start; k <= end; k++ {
+		if k != end && s[k] != '-' {
+			continue
+		}
+
+		if filenameWords[strings.ToLower(s[w:k])] {
+			cut := w
+			for cut > start && s[cut-1] == '-' {
+				cut--
+			}
+			return cut
+		}
+
+		w = k + 1
+	}
+
+	return end
+}
+
+// continuesNumber checks if digit at offset i of s is part of preceding number, either directly ("12")
+// or as next dot separated component ("1.2")
+func continuesNumber(s string, i int) bool {
+	if i == 0 {
+		return false
+	}
+
+	if isDigit(s[i-1]) {
+		return true
+	}
+
+	return s[i-1] == '.' && i > 1 && isDigit(s[i-2])
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierChar(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-'
+}
@@ -0,0 +1,94 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractVersion(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"myapp-1.2.3.tar.gz", "1.2.3"},
+		{"tool_v2.0.0-rc.1_linux_amd64.zip", "2.0.0-rc.1"},
+		{"release-1.2.3-linux", "1.2.3"},
+		{"myapp-1.2.3-linux-amd64.tar.gz", "1.2.3"},
+		{"myapp-1.2.3-rc.1-Linux-amd64.tar.gz", "1.2.3-rc.1"},
+		{"myapp-1.2.3-rc--darwin", "1.2.3-rc"},
+		{"myapp-1.2.3-beta.tar.gz", "1.2.3-beta"},
+		{"myapp-1.2.3+build.5.zip", "1.2.3+build.5"},
+		{"myapp-1.2.3-nightly-x", "1.2.3-nightly-x"},
+		{"libfoo.so.1.2.3", "1.2.3"},
+		{"app.1.2.3.jar", "1.2.3"},
+		{"app.v1.2.3", "1.2.3"},
+		{"1.2.3-rc.01", "1.2.3-rc"},
+		{"1.2.3-rc..1", "1.2.3-rc"},
+		{"1.2.3-.rc", "1.2.3"},
+		{"1.2.3+build..x", "1.2.3+build"},
+		{"1.2.3-rc.1+b.01 notes", "1.2.3-rc.1+b.01"},
+		{"1.2.3.4", "1.2.3"},
+		{"1.2.03", "1.2.0"},
+		{"app2-1.2.3", "1.2.3"},
+		{"from 1.0.0 to 2.0.0", "1.0.0"},
+		{"build 3.1.4+meta.5 done", "3.1.4+meta.5"},
+	}
+
+	for _, tc := range tests {
+		v, ok := ExtractVersion(tc.s)
+		require.True(t, ok, tc.s)
+		require.Equal(t, tc.expected, v.String(), tc.s)
+	}
+
+	for _, s := range []string{"", "no version here", "myapp-1.2.tar.gz", "1.02.3"} {
+		_, ok := ExtractVersion(s)
+		require.False(t, ok, s)
+	}
+}
+
+func TestExtractAllVersions(t *testing.T) {
+	changelog := `## 2.0.0 (2020-05-01)
+- drop support of 1.x, see 1.9.0 notes
+- fix regression from 2.0.0-rc.1
+
+## 1.9.0
+- bump dependency to v3.1.4+meta
+`
+
+	expected := Versions{
+		MustParse("2.0.0"),
+		MustParse("1.9.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.9.0"),
+		MustParse("3.1.4+meta"),
+	}
+
+	require.Equal(t, expected, ExtractAllVersions(changelog))
+	require.Nil(t, ExtractAllVersions("nothing here"))
+}
+
+func TestExtractAllVersionsLongRun(t *testing.T) {
+	s := strings.Repeat("1-", 100000)
+
+	start := time.Now()
+	require.Nil(t, ExtractAllVersions(s))
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	s = "1.2.3" + strings.Repeat("-a.1", 50000)
+	v, ok := ExtractVersion(s)
+	require.True(t, ok)
+	require.Equal(t, s, v.String())
+}
+
+func BenchmarkExtractAllVersionsHyphenRun(b *testing.B) {
+	s := strings.Repeat("1-", 2000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ExtractAllVersions(s)
+	}
+}
@@ -0,0 +1,28 @@
+package semver
+
+import (
+	"encoding/gob"
+)
+
+var _ gob.GobEncoder = (*Version)(nil)
+var _ gob.GobDecoder = (*Version)(nil)
+
+// GobEncode implements the encoding/gob.GobEncoder interface.
+func (v Version) GobEncode() ([]byte, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	return []byte(v.String()), nil
+}
+
+// GobDecode implements the encoding/gob.GobDecoder interface.
+func (v *Version) GobDecode(data []byte) error {
+	var err error
+
+	if *v, err = Parse(string(data)); err != nil {
+		return err
+	}
+
+	return nil
+}
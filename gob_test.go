@@ -0,0 +1,40 @@
+package semver
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGobRoundTrip(t *testing.T) {
+	versions := []Version{
+		MustParse("1.0.0"),
+		MustParse("1.2.3-beta.1"),
+		MustParse("3.1.4-alpha.1.5.9+build.2.6.5"),
+	}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(versions)
+	require.NoError(t, err)
+
+	var decoded []Version
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+	require.NoError(t, err)
+	require.Equal(t, versions, decoded)
+}
+
+func TestGobEncodeInValid(t *testing.T) {
+	var v Version
+	v.SetBuild([]string{"?"})
+
+	err := gob.NewEncoder(&bytes.Buffer{}).Encode(v)
+	require.Error(t, err)
+}
+
+func TestGobDecodeInValid(t *testing.T) {
+	var v Version
+	err := v.GobDecode([]byte("1.2"))
+	require.Error(t, err)
+}
@@ -2,10 +2,29 @@ package semver
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 )
 
 var _ json.Marshaler = (*Version)(nil)
 var _ json.Unmarshaler = (*Version)(nil)
+var _ json.Marshaler = (*Versions)(nil)
+var _ json.Unmarshaler = (*Versions)(nil)
+var _ json.Marshaler = (*StructVersion)(nil)
+var _ json.Unmarshaler = (*StructVersion)(nil)
+
+// StructVersion is a Version encoded to JSON as object instead of string:
+//
+//	{"major":1,"minor":2,"patch":3,"prerelease":"beta","metadata":""}
+type StructVersion Version
+
+type structVersionJSON struct {
+	Major      uint64 `json:"major"`
+	Minor      uint64 `json:"minor"`
+	Patch      uint64 `json:"patch"`
+	Prerelease string `json:"prerelease"`
+	Metadata   string `json:"metadata"`
+}
 
 // MarshalJSON implements the encoding/json.Marshaler interface.
 func (v Version) MarshalJSON() ([]byte, error) {
@@ -31,3 +50,134 @@ func (v *Version) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// DecodeVersions decodes JSON array of version strings from r element by element,
+// without reading whole input into memory. Decoding stops at the first invalid element
+func DecodeVersions(r io.Reader) (Versions, error) {
+	dec := json.NewDecoder(r)
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("semver: expected JSON array, got %v", tok)
+	}
+
+	res := Versions{}
+
+	for i := 0; dec.More(); i++ {
+		var str string
+		if err = dec.Decode(&str); err != nil {
+			return nil, fmt.Errorf("semver: invalid element at index %d: %w", i, err)
+		}
+
+		v, err := Parse(str)
+		if err != nil {
+			return nil, fmt.Errorf("semver: invalid version at index %d: %w", i, err)
+		}
+
+		res = append(res, v)
+	}
+
+	if _, err = dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+// Versions are encoded as array of strings, nil Versions as null
+func (s Versions) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	res := make([]string, 0, len(s))
+
+	for i, v := range s {
+		if err := v.Validate(); err != nil {
+			return nil, fmt.Errorf("semver: invalid version at index %d: %w", i, err)
+		}
+
+		res = append(res, v.String())
+	}
+
+	return json.Marshal(res)
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+// JSON null leaves s unchanged
+func (s *Versions) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var strs []string
+
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return err
+	}
+
+	res := make(Versions, 0, len(strs))
+
+	for i, str := range strs {
+		v, err := Parse(str)
+		if err != nil {
+			return fmt.Errorf("semver: invalid version at index %d: %w", i, err)
+		}
+
+		res = append(res, v)
+	}
+
+	*s = res
+
+	return nil
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+func (s StructVersion) MarshalJSON() ([]byte, error) {
+	v := Version(s)
+
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(structVersionJSON{
+		Major:      v.major,
+		Minor:      v.minor,
+		Patch:      v.patch,
+		Prerelease: v.PrerelString(),
+		Metadata:   v.BuildString(),
+	})
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+func (s *StructVersion) UnmarshalJSON(data []byte) error {
+	var obj structVersionJSON
+	var err error
+
+	if err = json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	v := Version{
+		major: obj.Major,
+		minor: obj.Minor,
+		patch: obj.Patch,
+	}
+
+	if v.pre, err = NewPrerelease(obj.Prerelease); err != nil {
+		return err
+	}
+
+	if v.build, err = NewBuild(obj.Metadata); err != nil {
+		return err
+	}
+
+	*s = StructVersion(v)
+
+	return nil
+}
@@ -2,7 +2,9 @@ package semver
 
 import (
 	"encoding/json"
+	"errors"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -49,3 +51,125 @@ func TestJSONUnmarshalInValid(t *testing.T) {
 	err = json.Unmarshal([]byte("3.1"), &v)
 	require.Error(t, err)
 }
+
+func TestJSONVersionsRoundTrip(t *testing.T) {
+	versions := Versions{
+		MustParse("1.0.0"),
+		MustParse("1.2.3-beta.1"),
+		MustParse("2.0.0+build.5"),
+	}
+
+	data, err := json.Marshal(versions)
+	require.NoError(t, err)
+	require.Equal(t, `["1.0.0","1.2.3-beta.1","2.0.0+build.5"]`, string(data))
+
+	var decoded Versions
+	err = json.Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, versions, decoded)
+}
+
+func TestJSONVersionsUnmarshalInValid(t *testing.T) {
+	var decoded Versions
+	err := json.Unmarshal([]byte(`["1.0.0","1.0"]`), &decoded)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "index 1")
+
+	err = json.Unmarshal([]byte(`"1.0.0"`), &decoded)
+	require.Error(t, err)
+
+	err = json.Unmarshal([]byte(`["1.0.0",""]`), &decoded)
+	require.True(t, errors.Is(err, ErrEmptyVersion))
+
+	err = json.Unmarshal([]byte(`["1.0.0-beta..1"]`), &decoded)
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+
+	_, err = json.Marshal(Versions{{1, 0, 0, []PRVersion{prstr("")}, nil}})
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+}
+
+func TestJSONVersionsNull(t *testing.T) {
+	var holder struct {
+		V Versions
+	}
+
+	data, err := json.Marshal(holder)
+	require.NoError(t, err)
+	require.Equal(t, `{"V":null}`, string(data))
+
+	holder.V = Versions{}
+	data, err = json.Marshal(holder)
+	require.NoError(t, err)
+	require.Equal(t, `{"V":[]}`, string(data))
+
+	holder.V = nil
+	require.NoError(t, json.Unmarshal([]byte(`{"V":null}`), &holder))
+	require.Nil(t, holder.V)
+
+	holder.V = Versions{MustParse("1.0.0")}
+	require.NoError(t, json.Unmarshal([]byte(`{"V":null}`), &holder))
+	require.Equal(t, Versions{MustParse("1.0.0")}, holder.V)
+}
+
+func TestJSONStructVersionRoundTrip(t *testing.T) {
+	tests := []struct {
+		v    string
+		json string
+	}{
+		{"1.2.3-beta.1+build.5", `{"major":1,"minor":2,"patch":3,"prerelease":"beta.1","metadata":"build.5"}`},
+		{"1.2.3", `{"major":1,"minor":2,"patch":3,"prerelease":"","metadata":""}`},
+	}
+
+	for _, tc := range tests {
+		data, err := json.Marshal(StructVersion(MustParse(tc.v)))
+		require.NoError(t, err)
+		require.Equal(t, tc.json, string(data))
+
+		var decoded StructVersion
+		err = json.Unmarshal(data, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, tc.v, Version(decoded).String())
+	}
+}
+
+func TestJSONStructVersionInValid(t *testing.T) {
+	var v Version
+	v.SetBuild([]string{"?"})
+
+	_, err := json.Marshal(StructVersion(v))
+	require.Error(t, err)
+
+	var decoded StructVersion
+	err = json.Unmarshal([]byte(`{"major":1,"minor":2,"patch":3,"prerelease":"01"}`), &decoded)
+	require.Error(t, err)
+
+	err = json.Unmarshal([]byte(`"1.2.3"`), &decoded)
+	require.Error(t, err)
+}
+
+func TestDecodeVersions(t *testing.T) {
+	versions, err := DecodeVersions(strings.NewReader(`["1.0.0", "1.2.3-beta.1", "2.0.0+build"]`))
+	require.NoError(t, err)
+	require.Equal(t, Versions{MustParse("1.0.0"), MustParse("1.2.3-beta.1"), MustParse("2.0.0+build")}, versions)
+
+	versions, err = DecodeVersions(strings.NewReader(`[]`))
+	require.NoError(t, err)
+	require.Empty(t, versions)
+}
+
+func TestDecodeVersionsInValid(t *testing.T) {
+	_, err := DecodeVersions(strings.NewReader(`["1.0.0", "1.0"]`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "index 1")
+
+	_, err = DecodeVersions(strings.NewReader(`["1.0.0", " "]`))
+	require.True(t, errors.Is(err, ErrEmptyVersion))
+
+	_, err = DecodeVersions(strings.NewReader(`["1.0.0-rc..1"]`))
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+
+	for _, s := range []string{`{"a":"1.0.0"}`, `["1.0.0", 1]`, `["1.0.0"`, ``} {
+		_, err = DecodeVersions(strings.NewReader(s))
+		require.Error(t, err, s)
+	}
+}
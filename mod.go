@@ -0,0 +1,41 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+)
+
+// CanonicalMod returns version in golang.org/x/mod/semver canonical form:
+// "v" prefixed, build metadata stripped, e.g. "v1.2.3-pre"
+func (v Version) CanonicalMod() string {
+	c := v
+	c.build = nil
+
+	return "v" + c.String()
+}
+
+// FromMod parses golang.org/x/mod/semver style version string.
+// Leading "v" is required and shorthands "v1" and "v1.2" are expanded to "v1.0.0" and "v1.2.0"
+func FromMod(s string) (Version, error) {
+	if !strings.HasPrefix(s, "v") {
+		return Version{}, errors.New("semver: module version must start with \"v\"")
+	}
+
+	s = s[1:]
+	if strings.HasPrefix(s, "v") || strings.HasPrefix(s, "V") {
+		return Version{}, errors.New("semver: module version must have single \"v\" prefix")
+	}
+
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 3 {
+		if strings.ContainsAny(parts[len(parts)-1], "+-") {
+			return Version{}, errors.New("semver: short version cannot contain PreRelease/Build metadata")
+		}
+
+		for len(parts) < 3 {
+			parts = append(parts, "0")
+		}
+	}
+
+	return Parse(strings.Join(parts, "."))
+}
@@ -0,0 +1,33 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModRoundTrip(t *testing.T) {
+	v, err := FromMod("v1.2.3-pre")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-pre", v.String())
+	require.Equal(t, "v1.2.3-pre", v.CanonicalMod())
+}
+
+func TestCanonicalMod(t *testing.T) {
+	require.Equal(t, "v1.2.3", MustParse("1.2.3+build").CanonicalMod())
+}
+
+func TestFromMod(t *testing.T) {
+	v, err := FromMod("v1")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", v.String())
+
+	v, err = FromMod("v1.2")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", v.String())
+
+	for _, s := range []string{"1.2.3", "V1.2.3", "v1.2-pre", "v01.2.3", "v", "vv1.2.3"} {
+		_, err = FromMod(s)
+		require.Error(t, err, s)
+	}
+}
@@ -1,5 +1,22 @@
 package semver
 
+import (
+	"strings"
+	"time"
+)
+
+// Ordering represents result of comparison of two versions
+type Ordering int
+
+const (
+	// OrderLess left-hand side is less than right-hand side
+	OrderLess Ordering = -1
+	// OrderEqual both sides are equal
+	OrderEqual Ordering = 0
+	// OrderGreater left-hand side is greater than right-hand side
+	OrderGreater Ordering = 1
+)
+
 // Equals checks if v is equal to o.
 func (v Version) Equals(o Version) bool {
 	return v.Compare(o) == 0
@@ -50,23 +67,8 @@ func (v Version) LE(o Version) bool {
 // 0 == v is equal to o
 // 1 == v is greater than o
 func (v Version) Compare(o Version) int {
-	if v.major != o.major {
-		if v.major > o.major {
-			return 1
-		}
-		return -1
-	}
-	if v.minor != o.minor {
-		if v.minor > o.minor {
-			return 1
-		}
-		return -1
-	}
-	if v.patch != o.patch {
-		if v.patch > o.patch {
-			return 1
-		}
-		return -1
+	if comp := v.compareCore(o); comp != 0 {
+		return comp
 	}
 
 	// Quick comparison if a version has no prerelease versions
@@ -99,6 +101,161 @@ func (v Version) Compare(o Version) int {
 	}
 }
 
+// CompareOptions alters behaviour of CompareOpts
+type CompareOptions struct {
+	// MetadataTiebreak orders versions with equal precedence by build metadata lexically
+	MetadataTiebreak bool
+}
+
+// CompareOpts is like Compare but applies opts
+func (v Version) CompareOpts(o Version, opts CompareOptions) int {
+	comp := v.Compare(o)
+	if comp != 0 || !opts.MetadataTiebreak {
+		return comp
+	}
+
+	return strings.Compare(v.BuildString(), o.BuildString())
+}
+
+// CompareWithMetadataDate is like Compare but orders versions with equal precedence
+// by date encoded in build metadata, e.g. "1.2.3+20240115" with layout "20060102".
+// Metadata is compared lexically if either of them cannot be parsed using layout
+func (v Version) CompareWithMetadataDate(o Version, layout string) int {
+	if comp := v.Compare(o); comp != 0 {
+		return comp
+	}
+
+	vb, ob := v.BuildString(), o.BuildString()
+
+	vt, verr := time.Parse(layout, vb)
+	ot, oerr := time.Parse(layout, ob)
+	if verr != nil || oerr != nil {
+		return strings.Compare(vb, ob)
+	}
+
+	switch {
+	case vt.Before(ot):
+		return -1
+	case vt.After(ot):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Order compares Versions v to o, same as Compare but returns Ordering
+func (v Version) Order(o Version) Ordering {
+	return Ordering(v.Compare(o))
+}
+
+// CompareString parses s and compares v to it, see Compare.
+// Returns parse error if s is not a valid version
+func (v Version) CompareString(s string) (int, error) {
+	o, err := Parse(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.Compare(o), nil
+}
+
+// CompareStrings parses a and b leniently (see ParseTolerant) and compares them, see Compare.
+// Returns parse error if either of them is not a valid version
+func CompareStrings(a, b string) (int, error) {
+	va, err := ParseTolerant(a)
+	if err != nil {
+		return 0, err
+	}
+
+	vb, err := ParseTolerant(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return va.Compare(vb), nil
+}
+
+// Distance returns signed per-component difference between o and v (o - v).
+// Prerelease and build metadata are ignored.
+// Components larger than math.MaxInt64 are not supported
+func (v Version) Distance(o Version) (majorDiff, minorDiff, patchDiff int64) {
+	majorDiff = int64(o.major) - int64(v.major)
+	minorDiff = int64(o.minor) - int64(v.minor)
+	patchDiff = int64(o.patch) - int64(v.patch)
+
+	return
+}
+
+// SameRelease checks if v and o have equal major, minor and patch versions
+// regardless of prerelease and build metadata, e.g. "1.2.3-rc.2" and "1.2.3"
+func (v Version) SameRelease(o Version) bool {
+	return v.compareCore(o) == 0
+}
+
+// IsImmediateSuccessorOf checks if v directly follows o: one of major, minor or patch versions
+// is incremented by one and lower components are zero, e.g. "1.2.4", "1.3.0" and "2.0.0" succeed "1.2.3".
+// Prerelease and build metadata are ignored
+func (v Version) IsImmediateSuccessorOf(o Version) bool {
+	switch {
+	case v.major > o.major:
+		return v.major-o.major == 1 && v.minor == 0 && v.patch == 0
+	case v.major != o.major:
+		return false
+	case v.minor > o.minor:
+		return v.minor-o.minor == 1 && v.patch == 0
+	case v.minor != o.minor:
+		return false
+	default:
+		return v.patch > o.patch && v.patch-o.patch == 1
+	}
+}
+
+// IsDowngradeFrom checks if moving from o to v is a downgrade, i.e. v is less than o.
+// Prerelease precedence applies: "1.2.3-rc1" is a downgrade from "1.2.3"
+func (v Version) IsDowngradeFrom(o Version) bool {
+	return v.LT(o)
+}
+
+// IsBreaking checks if moving from version from to version to is a breaking change:
+// major version differs, or for 0.x versions minor version differs
+func IsBreaking(from, to Version) bool {
+	if from.major != to.major {
+		return true
+	}
+
+	return from.major == 0 && from.minor != to.minor
+}
+
+// IsCompatibleWith checks if o can be used where v is required under caret rules:
+// o is greater than or equal to v and moving from v to o is not breaking, see IsBreaking
+func (v Version) IsCompatibleWith(o Version) bool {
+	return o.GTE(v) && !IsBreaking(v, o)
+}
+
+// compareCore compares major, minor and patch versions of v to o
+func (v Version) compareCore(o Version) int {
+	if v.major != o.major {
+		if v.major > o.major {
+			return 1
+		}
+		return -1
+	}
+	if v.minor != o.minor {
+		if v.minor > o.minor {
+			return 1
+		}
+		return -1
+	}
+	if v.patch != o.patch {
+		if v.patch > o.patch {
+			return 1
+		}
+		return -1
+	}
+
+	return 0
+}
+
 // Compare compares two PreRelease Versions v and o:
 // -1 == v is less than o
 // 0 == v is equal to o
@@ -0,0 +1,71 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Options configures Parser
+type Options struct {
+	// Tolerant normalizes input before parsing, see ParseTolerant
+	Tolerant bool
+	// ForbidVPrefix rejects versions with "v" prefix with ErrVPrefixNotAllowed
+	ForbidVPrefix bool
+	// ForbidBuild rejects versions carrying build metadata with ErrBuildNotAllowed
+	ForbidBuild bool
+	// ForbidLeadingHyphenInPrerelease rejects prerelease identifiers starting with "-",
+	// e.g. "1.2.3-alpha.-1", with wrapped ErrInvalidPrerelease
+	ForbidLeadingHyphenInPrerelease bool
+}
+
+// Parser parses versions using options configured once, see NewParser
+type Parser struct {
+	opts Options
+}
+
+// NewParser returns Parser configured with opts.
+// Zero Options give the same behaviour as Parse
+func NewParser(opts Options) Parser {
+	return Parser{opts: opts}
+}
+
+// Parse parses version string according to parser options and returns a validated Version or error
+func (p Parser) Parse(s string) (Version, error) {
+	if p.opts.ForbidVPrefix {
+		t := s
+		if p.opts.Tolerant {
+			t = strings.TrimPrefix(strings.TrimSpace(t), "=")
+		}
+
+		if strings.HasPrefix(t, "v") || strings.HasPrefix(t, "V") {
+			return Version{}, ErrVPrefixNotAllowed
+		}
+	}
+
+	var v Version
+	var err error
+
+	if p.opts.Tolerant {
+		v, err = ParseTolerant(s)
+	} else {
+		v, err = Parse(s)
+	}
+
+	if err != nil {
+		return Version{}, err
+	}
+
+	if p.opts.ForbidBuild && len(v.build) != 0 {
+		return Version{}, ErrBuildNotAllowed
+	}
+
+	if p.opts.ForbidLeadingHyphenInPrerelease {
+		for _, pre := range v.pre {
+			if strings.HasPrefix(pre.VersionStr, "-") {
+				return Version{}, fmt.Errorf("%w: identifier %q starts with hyphen", ErrInvalidPrerelease, pre.VersionStr)
+			}
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,104 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser(t *testing.T) {
+	tests := []struct {
+		opts     Options
+		v        string
+		expected string
+		err      error
+	}{
+		{Options{}, "1.2.3+build", "1.2.3+build", nil},
+		{Options{}, "v1.2.3", "1.2.3", nil},
+		{Options{ForbidBuild: true}, "1.2.3-rc.1", "1.2.3-rc.1", nil},
+		{Options{ForbidBuild: true}, "1.2.3+build", "", ErrBuildNotAllowed},
+		{Options{ForbidVPrefix: true}, "1.2.3", "1.2.3", nil},
+		{Options{ForbidVPrefix: true}, "v1.2.3", "", ErrVPrefixNotAllowed},
+		{Options{ForbidVPrefix: true, ForbidBuild: true}, "V1.2.3", "", ErrVPrefixNotAllowed},
+		{Options{ForbidVPrefix: true, ForbidBuild: true}, "1.2.3+build", "", ErrBuildNotAllowed},
+		{Options{ForbidVPrefix: true, ForbidBuild: true}, "1.2.3-rc.1", "1.2.3-rc.1", nil},
+		{Options{Tolerant: true}, " =v1.02 ", "1.2.0", nil},
+		{Options{Tolerant: true, ForbidBuild: true}, "v1.2.3+build", "", ErrBuildNotAllowed},
+		{Options{Tolerant: true, ForbidBuild: true}, "v1.2", "1.2.0", nil},
+		{Options{Tolerant: true, ForbidVPrefix: true}, " =v1.2", "", ErrVPrefixNotAllowed},
+		{Options{Tolerant: true, ForbidVPrefix: true}, " =1.2.3+build", "1.2.3+build", nil},
+		{Options{Tolerant: true, ForbidVPrefix: true, ForbidBuild: true}, "01.2", "1.2.0", nil},
+		{Options{Tolerant: true, ForbidVPrefix: true, ForbidBuild: true}, "1.02.3+build", "", ErrBuildNotAllowed},
+	}
+
+	for _, tc := range tests {
+		v, err := NewParser(tc.opts).Parse(tc.v)
+		if tc.err != nil {
+			require.Equal(t, tc.err, err, "%q with %+v", tc.v, tc.opts)
+			continue
+		}
+
+		require.NoError(t, err, "%q with %+v", tc.v, tc.opts)
+		require.Equal(t, tc.expected, v.String(), "%q with %+v", tc.v, tc.opts)
+	}
+
+	_, err := NewParser(Options{}).Parse("1.2")
+	require.Error(t, err)
+
+	_, err = NewParser(Options{Tolerant: true}).Parse("1.2.x")
+	require.Error(t, err)
+}
+
+func TestParserForbidLeadingHyphenInPrerelease(t *testing.T) {
+	for _, s := range []string{"1.2.3-alpha.-1", "1.2.3--1", "1.2.3-rc.1.-beta+build"} {
+		_, err := NewParser(Options{}).Parse(s)
+		require.NoError(t, err, s)
+
+		_, err = NewParser(Options{ForbidLeadingHyphenInPrerelease: true}).Parse(s)
+		require.True(t, errors.Is(err, ErrInvalidPrerelease), s)
+	}
+
+	v, err := NewParser(Options{ForbidLeadingHyphenInPrerelease: true}).Parse("1.2.3-alpha-1.x-")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-alpha-1.x-", v.String())
+}
+
+func BenchmarkParserStrict(b *testing.B) {
+	const VERSION = "1.0.8-alpha.preview+123.456"
+	p := NewParser(Options{ForbidVPrefix: true})
+	if _, err := p.Parse(VERSION); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = p.Parse(VERSION)
+	}
+}
+
+func BenchmarkParserTolerant(b *testing.B) {
+	const VERSION = "v1.08.0-alpha.preview+123.456"
+	p := NewParser(Options{Tolerant: true})
+	if _, err := p.Parse(VERSION); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = p.Parse(VERSION)
+	}
+}
+
+func BenchmarkParserForbidAll(b *testing.B) {
+	const VERSION = "1.0.8-alpha.preview"
+	p := NewParser(Options{Tolerant: true, ForbidVPrefix: true, ForbidBuild: true})
+	if _, err := p.Parse(VERSION); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = p.Parse(VERSION)
+	}
+}
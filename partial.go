@@ -0,0 +1,56 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+)
+
+// PartialVersion represents a version which may omit trailing components, e.g. "1" or "1.2".
+// Omitted components are treated as wildcards by Matches
+type PartialVersion struct {
+	v     Version
+	parts int
+}
+
+// ParsePartial parses version string which may omit minor and patch versions
+func ParsePartial(s string) (PartialVersion, error) {
+	if strings.TrimSpace(s) == "" {
+		return PartialVersion{}, ErrEmptyVersion
+	}
+
+	s = strings.TrimPrefix(s, "v")
+	s = strings.TrimPrefix(s, "V")
+
+	parts := strings.SplitN(s, ".", 3)
+	nparts := len(parts)
+
+	if nparts < 3 {
+		if strings.ContainsAny(parts[nparts-1], "+-") {
+			return PartialVersion{}, errors.New("semver: short version cannot contain PreRelease/Build metadata")
+		}
+
+		for len(parts) < 3 {
+			parts = append(parts, "0")
+		}
+	}
+
+	v, err := Parse(strings.Join(parts, "."))
+	if err != nil {
+		return PartialVersion{}, err
+	}
+
+	return PartialVersion{v: v, parts: nparts}, nil
+}
+
+// Matches checks if v matches p. Components omitted in p match any value,
+// so "1.2" matches "1.2.9" while "1.2.0" matches only "1.2.0"
+func (p PartialVersion) Matches(v Version) bool {
+	switch p.parts {
+	case 1:
+		return v.major == p.v.major
+	case 2:
+		return v.major == p.v.major && v.minor == p.v.minor
+	default:
+		return v.Equals(p.v)
+	}
+}
@@ -0,0 +1,43 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePartial(t *testing.T) {
+	tests := []struct {
+		p string
+		v string
+		b bool
+	}{
+		{"1.2", "1.2.0", true},
+		{"1.2", "1.2.9", true},
+		{"1.2", "1.3.0", false},
+		{"v1", "1.9.9", true},
+		{"1", "2.0.0", false},
+		{"1.2.0", "1.2.0", true},
+		{"1.2.0", "1.2.9", false},
+		{"1.2.0-rc.1", "1.2.0-rc.1", true},
+		{"1.2.0-rc.1", "1.2.0", false},
+	}
+
+	for _, tc := range tests {
+		p, err := ParsePartial(tc.p)
+		require.NoError(t, err)
+		require.Equal(t, tc.b, p.Matches(MustParse(tc.v)), "%q matching %q", tc.p, tc.v)
+	}
+}
+
+func TestParsePartialInValid(t *testing.T) {
+	for _, s := range []string{"", "1.x", "1.2-rc", "01.2", "1.2.3.4"} {
+		_, err := ParsePartial(s)
+		require.Error(t, err, s)
+	}
+
+	for _, s := range []string{"", " ", "\n"} {
+		_, err := ParsePartial(s)
+		require.Equal(t, ErrEmptyVersion, err, "%q", s)
+	}
+}
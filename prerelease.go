@@ -0,0 +1,267 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// IncrementPrerelease increments the prerelease version:
+//   - numeric last identifier is incremented: "1.2.3-rc.1" -> "1.2.3-rc.2"
+//   - trailing number of alphanumeric last identifier is incremented: "1.2.3-rc1" -> "1.2.3-rc2"
+//   - alphanumeric last identifier without number gets "0" appended: "1.2.3-rc" -> "1.2.3-rc0"
+//   - version without prerelease gets patch incremented and prerelease "0": "1.2.3" -> "1.2.4-0"
+func (v *Version) IncrementPrerelease() error {
+	if len(v.pre) == 0 {
+		if err := v.IncrementPatch(); err != nil {
+			return err
+		}
+
+		v.pre = []PRVersion{{VersionNum: 0, IsNum: true}}
+		return nil
+	}
+
+	last := v.pre[len(v.pre)-1]
+
+	if last.IsNum {
+		if last.VersionNum == ^uint64(0) {
+			return ErrOutOfBound
+		}
+
+		last.VersionNum++
+	} else {
+		prefix, num := splitTrailingDigits(last.VersionStr)
+		if len(num) == 0 {
+			last.VersionStr = prefix + "0"
+		} else {
+			n, err := strconv.ParseUint(num, 10, 64)
+			if err != nil || n == ^uint64(0) {
+				return ErrOutOfBound
+			}
+
+			last.VersionStr = prefix + strconv.FormatUint(n+1, 10)
+		}
+	}
+
+	pre := v.Prerel()
+	pre[len(pre)-1] = last
+	v.pre = pre
+
+	return nil
+}
+
+// IncrementPrereleaseWrap is like IncrementPrerelease but once number of the last prerelease identifier
+// reaches max it increments the patch version and restarts numbering from 0:
+// "1.2.3-rc.999" with max 999 becomes "1.2.4-rc.0"
+func (v *Version) IncrementPrereleaseWrap(max uint64) error {
+	if len(v.pre) == 0 {
+		return v.IncrementPrerelease()
+	}
+
+	last := v.pre[len(v.pre)-1]
+	prefix := ""
+	num := last.VersionNum
+
+	if !last.IsNum {
+		var digits string
+		if prefix, digits = splitTrailingDigits(last.VersionStr); len(digits) == 0 {
+			return v.IncrementPrerelease()
+		}
+
+		n, err := strconv.ParseUint(digits, 10, 64)
+		if err != nil {
+			return ErrOutOfBound
+		}
+
+		num = n
+	}
+
+	if num < max {
+		return v.IncrementPrerelease()
+	}
+
+	if err := v.IncrementPatch(); err != nil {
+		return err
+	}
+
+	if last.IsNum {
+		last.VersionNum = 0
+	} else {
+		last.VersionStr = prefix + "0"
+	}
+
+	pre := v.Prerel()
+	pre[len(pre)-1] = last
+	v.pre = pre
+
+	return nil
+}
+
+// SetPrereleaseNumbered sets prerelease to prefix followed by a number.
+// If current prerelease already starts with prefix its number is incremented ("rc5" -> "rc6"),
+// otherwise numbering starts from 0 ("rc5" -> "beta0")
+func (v *Version) SetPrereleaseNumbered(prefix string) error {
+	return v.SetPrereleaseSep(prefix, "")
+}
+
+// SetPrereleaseSep is like SetPrereleaseNumbered but joins prefix and number with sep,
+// which must be either "" ("rc0") or "." ("rc.0")
+func (v *Version) SetPrereleaseSep(prefix, sep string) error {
+	if sep != "" && sep != "." {
+		return fmt.Errorf("semver: invalid prerelease separator %q", sep)
+	}
+
+	next := uint64(0)
+
+	if curPrefix, num := splitTrailingDigits(v.PrerelString()); curPrefix == prefix+sep && len(num) != 0 {
+		n, err := strconv.ParseUint(num, 10, 64)
+		if err != nil || n == ^uint64(0) {
+			return ErrOutOfBound
+		}
+
+		next = n + 1
+	}
+
+	pre, err := NewPrerelease(prefix + sep + strconv.FormatUint(next, 10))
+	if err != nil {
+		return err
+	}
+
+	v.pre = pre
+
+	return nil
+}
+
+// SetPrereleaseNumber sets prerelease to prefix and n separated by dot regardless of current
+// prerelease, e.g. "rc.5". With empty prefix prerelease is just the number ("5").
+// Build metadata is kept
+func (v *Version) SetPrereleaseNumber(prefix string, n uint64) error {
+	s := strconv.FormatUint(n, 10)
+	if prefix != "" {
+		s = prefix + "." + s
+	}
+
+	pre, err := NewPrerelease(s)
+	if err != nil {
+		return err
+	}
+
+	v.pre = pre
+
+	return nil
+}
+
+// StartPrerelease increments the patch version and starts prerelease numbering from 0:
+// "1.2.3" with prefix "rc" becomes "1.2.4-rc.0", with empty prefix "1.2.4-0"
+func (v *Version) StartPrerelease(prefix string) error {
+	pr := "0"
+	if len(prefix) != 0 {
+		pr = prefix + ".0"
+	}
+
+	pre, err := NewPrerelease(pr)
+	if err != nil {
+		return err
+	}
+
+	if err = v.IncrementPatch(); err != nil {
+		return err
+	}
+
+	v.pre = pre
+
+	return nil
+}
+
+// IncrementPatchKeepChannel increments the patch version keeping prerelease channel
+// (the leading prerelease identifier) with numbering reset: "1.2.3-rc.2" becomes "1.2.4-rc.0"
+// and "1.2.3-rc2" becomes "1.2.4-rc0". Versions without prerelease stay stable
+func (v *Version) IncrementPatchKeepChannel() error {
+	return v.incrementKeepChannel(v.IncrementPatch)
+}
+
+// IncrementMinorKeepChannel is like IncrementPatchKeepChannel but increments the minor version
+func (v *Version) IncrementMinorKeepChannel() error {
+	return v.incrementKeepChannel(v.IncrementMinor)
+}
+
+// IncrementMajorKeepChannel is like IncrementPatchKeepChannel but increments the major version
+func (v *Version) IncrementMajorKeepChannel() error {
+	return v.incrementKeepChannel(v.IncrementMajor)
+}
+
+func (v *Version) incrementKeepChannel(inc func() error) error {
+	var pre []PRVersion
+
+	if len(v.pre) != 0 {
+		zero := PRVersion{VersionNum: 0, IsNum: true}
+		ch := v.pre[0]
+
+		switch prefix, num := splitTrailingDigits(ch.VersionStr); {
+		case ch.IsNum:
+			pre = []PRVersion{zero}
+		case len(v.pre) == 1 && len(num) != 0:
+			pre = []PRVersion{{VersionStr: prefix + "0"}}
+		default:
+			pre = []PRVersion{ch, zero}
+		}
+	}
+
+	if err := inc(); err != nil {
+		return err
+	}
+
+	v.pre = pre
+
+	return nil
+}
+
+// PrereleaseSeq returns iterator over prerelease identifiers of v, e.g. "beta", "1", "final"
+// for "1.2.3-beta.1.final", without allocating a slice. Iteration stops once yield returns false.
+// Its signature matches iter.Seq[string], so on Go 1.23+ it can be used with range-over-func
+func (v Version) PrereleaseSeq() func(yield func(string) bool) {
+	return func(yield func(string) bool) {
+		for _, pre := range v.pre {
+			if !yield(pre.String()) {
+				return
+			}
+		}
+	}
+}
+
+// TokenizePrerelease splits prerelease string s on dots and on every boundary
+// between digits and non-digits: "rc0beta1" -> ["rc", "0", "beta", "1"],
+// "alpha.1-x" -> ["alpha", "1", "-x"]. Empty tokens are omitted
+func TokenizePrerelease(s string) []string {
+	var tokens []string
+
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		switch {
+		case i == len(s), s[i] == '.':
+		case i > start && isDigit(s[i]) != isDigit(s[i-1]):
+		default:
+			continue
+		}
+
+		if i > start {
+			tokens = append(tokens, s[start:i])
+		}
+
+		start = i
+		if i < len(s) && s[i] == '.' {
+			start++
+		}
+	}
+
+	return tokens
+}
+
+// splitTrailingDigits splits s into prefix and trailing decimal digits
+func splitTrailingDigits(s string) (string, string) {
+	i := len(s)
+	for i > 0 && s[i-1] >= '0' && s[i-1] <= '9' {
+		i--
+	}
+
+	return s[:i], s[i:]
+}
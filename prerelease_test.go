@@ -0,0 +1,234 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementPrerelease(t *testing.T) {
+	tests := []struct {
+		v        string
+		expected string
+	}{
+		{"1.2.3-rc.1", "1.2.3-rc.2"},
+		{"1.2.3-rc1", "1.2.3-rc2"},
+		{"1.2.3-rc", "1.2.3-rc0"},
+		{"1.2.3-rc.9+build", "1.2.3-rc.10+build"},
+		{"1.2.3", "1.2.4-0"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		require.NoError(t, v.IncrementPrerelease())
+		require.Equal(t, tc.expected, v.String())
+		require.NoError(t, v.Validate())
+	}
+
+	v := Version{1, 2, 3, []PRVersion{prnum(^uint64(0))}, nil}
+	require.Equal(t, ErrOutOfBound, v.IncrementPrerelease())
+}
+
+func TestIncrementPrereleaseNoAlias(t *testing.T) {
+	v := MustParse("1.2.3-rc.1")
+	pre := v.Prerel()
+
+	c := v
+	require.NoError(t, c.IncrementPrerelease())
+	require.Equal(t, pre, v.Prerel())
+}
+
+func TestIncrementPrereleaseWrap(t *testing.T) {
+	tests := []struct {
+		v        string
+		expected string
+	}{
+		{"1.2.3-rc.1", "1.2.3-rc.2"},
+		{"1.2.3-rc.998", "1.2.3-rc.999"},
+		{"1.2.3-rc.999", "1.2.4-rc.0"},
+		{"1.2.3-rc999", "1.2.4-rc0"},
+		{"1.2.3-rc", "1.2.3-rc0"},
+		{"1.2.3", "1.2.4-0"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		require.NoError(t, v.IncrementPrereleaseWrap(999))
+		require.Equal(t, tc.expected, v.String())
+	}
+
+	v := Version{1, 2, ^uint64(0), []PRVersion{prnum(999)}, nil}
+	require.Equal(t, ErrOutOfBound, v.IncrementPrereleaseWrap(999))
+}
+
+func TestSetPrereleaseNumbered(t *testing.T) {
+	tests := []struct {
+		v        string
+		prefix   string
+		expected string
+	}{
+		{"1.2.3-rc", "beta", "1.2.3-beta0"},
+		{"1.2.3-rc5", "beta", "1.2.3-beta0"},
+		{"1.2.3-rc5", "rc", "1.2.3-rc6"},
+		{"1.2.3-rc", "rc", "1.2.3-rc0"},
+		{"1.2.3", "rc", "1.2.3-rc0"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		require.NoError(t, v.SetPrereleaseNumbered(tc.prefix))
+		require.Equal(t, tc.expected, v.String())
+	}
+
+	v := MustParse("1.2.3")
+	require.Error(t, v.SetPrereleaseNumbered("r?c"))
+	require.Equal(t, "1.2.3", v.String())
+}
+
+func TestSetPrereleaseSep(t *testing.T) {
+	tests := []struct {
+		v        string
+		prefix   string
+		sep      string
+		expected string
+	}{
+		{"1.2.3", "rc", ".", "1.2.3-rc.0"},
+		{"1.2.3-rc.0", "rc", ".", "1.2.3-rc.1"},
+		{"1.2.3-rc0", "rc", ".", "1.2.3-rc.0"},
+		{"1.2.3-rc.4", "beta", ".", "1.2.3-beta.0"},
+		{"1.2.3", "rc", "", "1.2.3-rc0"},
+		{"1.2.3-rc0", "rc", "", "1.2.3-rc1"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		require.NoError(t, v.SetPrereleaseSep(tc.prefix, tc.sep))
+		require.Equal(t, tc.expected, v.String())
+		require.NoError(t, v.Validate())
+	}
+
+	v := MustParse("1.2.3")
+	require.Error(t, v.SetPrereleaseSep("rc", "-"))
+	require.Error(t, v.SetPrereleaseSep("", "."))
+}
+
+func TestSetPrereleaseNumber(t *testing.T) {
+	tests := []struct {
+		v        string
+		prefix   string
+		n        uint64
+		expected string
+	}{
+		{"1.2.3", "rc", 5, "1.2.3-rc.5"},
+		{"1.2.3-rc.1", "rc", 5, "1.2.3-rc.5"},
+		{"1.2.3-rc.9", "rc", 5, "1.2.3-rc.5"},
+		{"1.2.3-beta.2+build", "rc", 0, "1.2.3-rc.0+build"},
+		{"1.2.3-rc.1", "", 5, "1.2.3-5"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		require.NoError(t, v.SetPrereleaseNumber(tc.prefix, tc.n))
+		require.Equal(t, tc.expected, v.String())
+		require.NoError(t, v.Validate())
+	}
+
+	// auto mode keeps incrementing
+	v := MustParse("1.2.3-rc.1")
+	require.NoError(t, v.SetPrereleaseSep("rc", "."))
+	require.Equal(t, "1.2.3-rc.2", v.String())
+
+	require.Error(t, v.SetPrereleaseNumber("r_c", 1))
+	require.Equal(t, "1.2.3-rc.2", v.String())
+}
+
+func TestStartPrerelease(t *testing.T) {
+	v := MustParse("1.2.3")
+	require.NoError(t, v.StartPrerelease(""))
+	require.Equal(t, "1.2.4-0", v.String())
+
+	v = MustParse("1.2.3")
+	require.NoError(t, v.StartPrerelease("rc"))
+	require.Equal(t, "1.2.4-rc.0", v.String())
+
+	v = MustParse("1.2.3")
+	require.Error(t, v.StartPrerelease("r?c"))
+	require.Equal(t, "1.2.3", v.String())
+}
+
+func TestIncrementKeepChannel(t *testing.T) {
+	tests := []struct {
+		v             string
+		incrementType int
+		expected      string
+	}{
+		{"1.2.3-rc.2", PATCH, "1.2.4-rc.0"},
+		{"1.2.3-rc.2", MINOR, "1.3.0-rc.0"},
+		{"1.2.3-rc.2", MAJOR, "2.0.0-rc.0"},
+		{"1.2.3-rc2", PATCH, "1.2.4-rc0"},
+		{"1.2.3-beta", PATCH, "1.2.4-beta.0"},
+		{"1.2.3-5", PATCH, "1.2.4-0"},
+		{"1.2.3+build", PATCH, "1.2.4+build"},
+	}
+
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+
+		var err error
+		switch tc.incrementType {
+		case PATCH:
+			err = v.IncrementPatchKeepChannel()
+		case MINOR:
+			err = v.IncrementMinorKeepChannel()
+		case MAJOR:
+			err = v.IncrementMajorKeepChannel()
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, v.String())
+	}
+
+	v := Version{1, 2, ^uint64(0), []PRVersion{prstr("rc"), prnum(1)}, nil}
+	require.Equal(t, ErrOutOfBound, v.IncrementPatchKeepChannel())
+	require.Equal(t, "rc.1", v.PrerelString())
+}
+
+func TestTokenizePrerelease(t *testing.T) {
+	tests := []struct {
+		pre      string
+		expected []string
+	}{
+		{"rc0", []string{"rc", "0"}},
+		{"rc.1", []string{"rc", "1"}},
+		{"rc0beta1", []string{"rc", "0", "beta", "1"}},
+		{"alpha.10b.x", []string{"alpha", "10", "b", "x"}},
+		{"alpha.1-x", []string{"alpha", "1", "-x"}},
+		{"42", []string{"42"}},
+		{"", nil},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, TokenizePrerelease(tc.pre), tc.pre)
+	}
+}
+
+func TestPrereleaseSeq(t *testing.T) {
+	var ids []string
+	MustParse("1.2.3-beta.1.final+build").PrereleaseSeq()(func(id string) bool {
+		ids = append(ids, id)
+		return true
+	})
+	require.Equal(t, []string{"beta", "1", "final"}, ids)
+
+	ids = nil
+	MustParse("1.2.3-beta.1.final").PrereleaseSeq()(func(id string) bool {
+		ids = append(ids, id)
+		return id != "1"
+	})
+	require.Equal(t, []string{"beta", "1"}, ids)
+
+	MustParse("1.2.3").PrereleaseSeq()(func(id string) bool {
+		t.Errorf("Unexpected identifier %q", id)
+		return true
+	})
+}
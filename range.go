@@ -29,6 +29,21 @@ func wildcardTypeFromInt(i int) wildcardType {
 	}
 }
 
+// RangePolicy defines which range is generated by Version.RangeString
+type RangePolicy int
+
+const (
+	// RangeExact matches version exactly: "=1.2.3"
+	RangeExact RangePolicy = iota
+	// RangeCaret allows changes which are not breaking (see IsBreaking): "^1.2.3"
+	RangeCaret
+	// RangeTilde allows patch-level changes: "~1.2.3"
+	RangeTilde
+	// RangeMinorPin allows patch-level changes as explicit bounds: ">=1.2.3, <1.3.0",
+	// for tools which do not understand "~"
+	RangeMinorPin
+)
+
 type comparator func(Version, Version) bool
 
 var (
@@ -85,6 +100,32 @@ func (rf Range) AND(f Range) Range {
 	}
 }
 
+// NOT negates the existing Range, matching exactly the versions it rejects.
+//   - NOT of ">=1.2.0" is "<1.2.0"
+//   - NOT of ">=1.0.0 <2.0.0" is "<1.0.0 || >=2.0.0"
+func (rf Range) NOT() Range {
+	return func(v Version) bool {
+		return !rf(v)
+	}
+}
+
+// Satisfies checks if v is in range r, same as r(v)
+func (v Version) Satisfies(r Range) bool {
+	return r(v)
+}
+
+// CheckAll checks every version in vs against the Range,
+// returned slice is parallel to vs
+func (rf Range) CheckAll(vs Versions) []bool {
+	res := make([]bool, len(vs))
+
+	for i, v := range vs {
+		res[i] = rf(v)
+	}
+
+	return res
+}
+
 // ParseRange parses a range and returns a Range.
 // If the range could not be parsed an error is returned.
 //
@@ -96,11 +137,30 @@ func (rf Range) AND(f Range) Range {
 //   - "1.0.0", "=1.0.0", "==1.0.0"
 //   - "!1.0.0", "!=1.0.0"
 //
-// A Range can consist of multiple ranges separated by space:
+// A Range can consist of multiple ranges separated by space or comma:
 // Ranges can be linked by logical AND:
 //   - ">1.0.0 <2.0.0" would match between both ranges, so "1.1.1" and "1.8.7" but not "1.0.0" or "2.0.0"
+//   - ">1.0.0, <2.0.0" is the same as above
 //   - ">1.0.0 <3.0.0 !2.0.3-beta.2" would match every version between 1.0.0 and 3.0.0 except 2.0.3-beta.2
 //
+// Hyphen ranges are inclusive on both ends, spaces around hyphen are required:
+//   - "1.2.3 - 2.3.4" is the same as ">=1.2.3 <=2.3.4"
+//   - "1.2 - 2.3" is the same as ">=1.2.0 <2.4.0"
+//
+// Caret ranges allow changes which are not breaking, see IsBreaking:
+//   - "^1.2.3" is the same as ">=1.2.3 <2.0.0"
+//   - "^0.2.3" is the same as ">=0.2.3 <0.3.0"
+//   - "^1.2" is the same as ">=1.2.0 <2.0.0"
+//
+// Tilde ranges allow patch-level changes, or minor-level if only major is given:
+//   - "~1.2.3" is the same as ">=1.2.3 <1.3.0"
+//   - "~1.2" is the same as ">=1.2.0 <1.3.0"
+//   - "~1" is the same as ">=1.0.0 <2.0.0"
+//
+// Pessimistic ranges allow only the rightmost given component to increase:
+//   - "~> 1.2" is the same as ">=1.2.0 <2.0.0"
+//   - "~> 1.2.3" is the same as ">=1.2.3 <1.3.0"
+//
 // Ranges can also be linked by logical OR:
 //   - "<2.0.0 || >=3.0.0" would match "1.x.x" and "3.x.x" but not "2.x.x"
 //
@@ -120,6 +180,14 @@ func ParseRange(s string) (Range, error) {
 		return nil, err
 	}
 
+	if orParts, err = expandHyphenRange(orParts); err != nil {
+		return nil, err
+	}
+
+	if orParts, err = expandShorthandRange(orParts); err != nil {
+		return nil, err
+	}
+
 	if expandedParts, err = expandWildcardVersion(orParts); err != nil {
 		return nil, err
 	}
@@ -158,6 +226,105 @@ func ParseRange(s string) (Range, error) {
 	return orFn, nil
 }
 
+// RangeString returns range expression for v under policy, e.g. "^1.2.3" for RangeCaret.
+// Every expression can be parsed by ParseRange. Upper bound of RangeMinorPin is omitted
+// if it cannot be represented. Unknown policy is treated as RangeExact
+func (v Version) RangeString(policy RangePolicy) string {
+	switch policy {
+	case RangeCaret:
+		return "^" + v.String()
+	case RangeTilde:
+		return "~" + v.String()
+	case RangeMinorPin:
+		upper, ok := nextMinor(v)
+		if !ok {
+			return ">=" + v.String()
+		}
+
+		return ">=" + v.String() + ", <" + upper.String()
+	default:
+		return "=" + v.String()
+	}
+}
+
+// nextMinor returns lowest version with greater major or minor version than v.
+// Returns false if there is no such version
+func nextMinor(v Version) (Version, bool) {
+	upper := Version{major: v.major, minor: v.minor}
+	if upper.IncrementMinor() == nil {
+		return upper, true
+	}
+
+	return nextMajor(v)
+}
+
+// nextMajor returns lowest version with greater major version than v.
+// Returns false if there is no such version
+func nextMajor(v Version) (Version, bool) {
+	upper := Version{major: v.major}
+	if upper.IncrementMajor() == nil {
+		return upper, true
+	}
+
+	return Version{}, false
+}
+
+// ParseExactRange returns a Range matching any of the given versions exactly,
+// equivalent to "=v1 || =v2 || ...". Build metadata is ignored during matching
+func ParseExactRange(versions ...string) (Range, error) {
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("semver: no versions given for exact range")
+	}
+
+	var orFn Range
+	for _, vStr := range versions {
+		vr, err := buildVersionRange("=", vStr)
+		if err != nil {
+			return nil, err
+		}
+
+		if orFn == nil {
+			orFn = vr.rangeFunc()
+		} else {
+			orFn = orFn.OR(vr.rangeFunc())
+		}
+	}
+
+	return orFn, nil
+}
+
+// CommonCaretRange returns Range satisfied by every version compatible with both a and b
+// under caret rules (see Version.IsCompatibleWith), e.g. ">=1.5.0 <2.0.0" for "1.2.0" and "1.5.0".
+// Returns false if there is no such version, i.e. moving between a and b is breaking, see IsBreaking
+func CommonCaretRange(a, b Version) (Range, bool) {
+	if IsBreaking(a, b) {
+		return nil, false
+	}
+
+	lower := a
+	if b.GT(a) {
+		lower = b
+	}
+
+	var upper Version
+	var ok bool
+
+	if lower.major == 0 {
+		upper, ok = nextMinor(lower)
+	} else {
+		upper, ok = nextMajor(lower)
+	}
+
+	lr := &versionRange{v: lower, c: compGE}
+	if !ok {
+		return lr.rangeFunc(), true
+	}
+
+	ur := &versionRange{v: upper, c: compLT}
+
+	return lr.rangeFunc().AND(ur.rangeFunc()), true
+}
+
 // splitORParts splits the already cleaned parts by '||'.
 // Checks for invalid positions of the operator and returns an
 // error if found.
@@ -182,6 +349,125 @@ func splitORParts(parts []string) ([][]string, error) {
 	return ORparts, nil
 }
 
+// expandHyphenRange expands hyphen ranges "A - B" inside already split parts
+// into ">=A" and "<=B". Short operands like "1.2" are turned into wildcards
+// so they are handled by expandWildcardVersion
+func expandHyphenRange(parts [][]string) ([][]string, error) {
+	var expandedParts [][]string
+	for _, p := range parts {
+		var newParts []string
+		for i := 0; i < len(p); i++ {
+			if i+1 < len(p) && p[i+1] == "-" {
+				if i+2 >= len(p) {
+					return nil, fmt.Errorf("semver: hyphen range %q has no upper bound", p[i])
+				}
+
+				from, to := p[i], p[i+2]
+				if !isHyphenOperand(from) || !isHyphenOperand(to) {
+					return nil, fmt.Errorf("semver: invalid hyphen range %q", strings.Join(p[i:i+3], " "))
+				}
+
+				newParts = append(newParts, ">="+hyphenOperand(from), "<="+hyphenOperand(to))
+				i += 2
+				continue
+			}
+
+			if p[i] == "-" {
+				return nil, fmt.Errorf("semver: hyphen range has no lower bound")
+			}
+
+			newParts = append(newParts, p[i])
+		}
+		expandedParts = append(expandedParts, newParts)
+	}
+
+	return expandedParts, nil
+}
+
+// expandShorthandRange replaces "~>", "^" and "~" conditions with lower and upper bounds,
+// e.g. "^1.2.3" with ">=1.2.3" and "<2.0.0", "~>1.2" with ">=1.2.0" and "<2.0.0"
+func expandShorthandRange(parts [][]string) ([][]string, error) {
+	var expandedParts [][]string
+	for _, p := range parts {
+		var newParts []string
+		for _, ap := range p {
+			var op string
+			switch {
+			case strings.HasPrefix(ap, "~>"):
+				op = "~>"
+			case strings.HasPrefix(ap, "^"):
+				op = "^"
+			case strings.HasPrefix(ap, "~"):
+				op = "~"
+			default:
+				newParts = append(newParts, ap)
+				continue
+			}
+
+			conds, err := shorthandConditions(op, ap[len(op):])
+			if err != nil {
+				return nil, fmt.Errorf("semver: could not parse Range %q: %s", ap, err)
+			}
+
+			newParts = append(newParts, conds...)
+		}
+		expandedParts = append(expandedParts, newParts)
+	}
+
+	return expandedParts, nil
+}
+
+// shorthandConditions returns lower and upper bound conditions of range given by operator op
+// and version s with one to three components. Upper bound is omitted if it cannot be represented
+func shorthandConditions(op, s string) ([]string, error) {
+	if len(s) == 0 || !isDigit(s[0]) {
+		return nil, fmt.Errorf("semver: invalid version %q for operator %q", s, op)
+	}
+
+	lower, err := ParseTolerant(s)
+	if err != nil {
+		return nil, err
+	}
+
+	core := s
+	if i := strings.IndexAny(s, "-+"); i != -1 {
+		core = s[:i]
+	}
+	components := strings.Count(core, ".") + 1
+
+	var upper Version
+	var ok bool
+
+	switch {
+	case op == "~>" && components == 3,
+		op == "~" && components > 1,
+		op == "^" && lower.major == 0 && components > 1:
+		upper, ok = nextMinor(lower)
+	default:
+		upper, ok = nextMajor(lower)
+	}
+
+	if !ok {
+		return []string{">=" + lower.String()}, nil
+	}
+
+	return []string{">=" + lower.String(), "<" + upper.String()}, nil
+}
+
+// isHyphenOperand checks if s is a version without comparator
+func isHyphenOperand(s string) bool {
+	return len(s) != 0 && isDigit(s[0])
+}
+
+// hyphenOperand turns short versions "1" and "1.2" into wildcards "1.x" and "1.2.x"
+func hyphenOperand(s string) string {
+	if strings.ContainsAny(s, "+-x") || strings.Count(s, ".") >= 2 {
+		return s
+	}
+
+	return s + ".x"
+}
+
 // buildVersionRange takes a slice of 2: operator and version
 // and builds a versionRange, otherwise an error.
 func buildVersionRange(opStr, vStr string) (*versionRange, error) {
@@ -211,14 +497,16 @@ func inArray(s byte, list []byte) bool {
 	return false
 }
 
-// splitAndTrim splits a range string by spaces and cleans whitespaces
+// splitAndTrim splits a range string by spaces or commas and cleans whitespaces
 func splitAndTrim(s string) (result []string) {
+	s = strings.Replace(s, ",", " ", -1)
+
 	last := 0
 	var lastChar byte
-	excludeFromSplit := []byte{'>', '<', '='}
+	excludeFromSplit := []byte{'>', '<', '=', '^', '~'}
 	for i := 0; i < len(s); i++ {
 		if s[i] == ' ' && !inArray(lastChar, excludeFromSplit) {
-			if last < i-1 {
+			if last < i {
 				result = append(result, s[last:i])
 			}
 			last = i + 1
@@ -226,7 +514,7 @@ func splitAndTrim(s string) (result []string) {
 			lastChar = s[i]
 		}
 	}
-	if last < len(s)-1 {
+	if last < len(s) {
 		result = append(result, s[last:])
 	}
 
@@ -416,6 +704,18 @@ func parseComparator(s string) comparator {
 	return nil
 }
 
+// ValidateRange checks if s is a valid range expression, see ParseRange.
+// Returns the parse error if it is not
+func ValidateRange(s string) error {
+	_, err := ParseRange(s)
+	return err
+}
+
+// IsValidRange checks if s is a valid range expression, see ParseRange
+func IsValidRange(s string) bool {
+	return ValidateRange(s) == nil
+}
+
 // MustParseRange is like ParseRange but panics if the range cannot be parsed.
 func MustParseRange(s string) Range {
 	r, err := ParseRange(s)
@@ -84,6 +84,9 @@ func TestSplitAndTrim(t *testing.T) {
 		{"  >=   1.2.3   <=  1.2.3   ", []string{">=1.2.3", "<=1.2.3"}}, // Spaces between operator and version
 		{"1.2.3 || >=1.2.3 <1.2.3", []string{"1.2.3", "||", ">=1.2.3", "<1.2.3"}},
 		{"      1.2.3      ||     >=1.2.3     <1.2.3    ", []string{"1.2.3", "||", ">=1.2.3", "<1.2.3"}},
+		{">=1.2.3, <2.0.0", []string{">=1.2.3", "<2.0.0"}}, // Commas
+		{">=1.2.3,<2.0.0", []string{">=1.2.3", "<2.0.0"}},
+		{"1.2.3 - 2.0.0", []string{"1.2.3", "-", "2.0.0"}},
 	}
 
 	for _, tc := range tests {
@@ -352,6 +355,43 @@ func TestRangeOR(t *testing.T) {
 	}
 }
 
+func TestRangeNOT(t *testing.T) {
+	tests := []struct {
+		r string
+		v string
+		b bool
+	}{
+		{">=1.2.0", "1.1.9", true},
+		{">=1.2.0", "1.2.0", false},
+		{">=1.2.0", "1.3.0", false},
+		{">=1.0.0 <2.0.0", "0.9.0", true},
+		{">=1.0.0 <2.0.0", "1.0.0", false},
+		{">=1.0.0 <2.0.0", "1.9.9", false},
+		{">=1.0.0 <2.0.0", "2.0.0", true},
+	}
+
+	for _, tc := range tests {
+		rf := MustParseRange(tc.r).NOT()
+		if res := rf(MustParse(tc.v)); res != tc.b {
+			t.Errorf("Invalid for case %q matching %q: Expected %t, got: %t", tc.r, tc.v, tc.b, res)
+		}
+	}
+}
+
+func TestRangeCheckAll(t *testing.T) {
+	vs := Versions{MustParse("0.9.0"), MustParse("1.2.0"), MustParse("2.0.0"), MustParse("1.0.0")}
+	expected := []bool{false, true, false, true}
+
+	res := MustParseRange(">=1.0.0 <2.0.0").CheckAll(vs)
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Invalid CheckAll result: Expected %v, got: %v", expected, res)
+	}
+
+	if res = MustParseRange(">=1.0.0").CheckAll(nil); len(res) != 0 {
+		t.Errorf("Expected empty result, got: %v", res)
+	}
+}
+
 func TestParseRange(t *testing.T) {
 	type tv struct {
 		v string
@@ -449,6 +489,38 @@ func TestParseRange(t *testing.T) {
 			{"1.2.3", false},
 			{"1.2.4", false},
 		}},
+		// Comma separated AND Expressions
+		{">1.2.2, <1.2.4", []tv{
+			{"1.2.2", false},
+			{"1.2.3", true},
+			{"1.2.4", false},
+		}},
+		{">=1.2.0,<2.0.0 || 3.0.0", []tv{
+			{"1.1.9", false},
+			{"1.9.9", true},
+			{"2.0.0", false},
+			{"3.0.0", true},
+		}},
+		// Hyphen ranges
+		{"1.2.3 - 2.3.4", []tv{
+			{"1.2.2", false},
+			{"1.2.3", true},
+			{"2.3.4", true},
+			{"2.3.5", false},
+		}},
+		{"1.2 - 2.3", []tv{
+			{"1.1.9", false},
+			{"1.2.0", true},
+			{"2.3.9", true},
+			{"2.4.0", false},
+		}},
+		{"1.2.3-beta - 1.2.3 || 2.0.0", []tv{
+			{"1.2.3-alpha", false},
+			{"1.2.3-beta", true},
+			{"1.2.3", true},
+			{"1.2.4", false},
+			{"2.0.0", true},
+		}},
 		// Wildcard expressions
 		{">1.x", []tv{
 			{"0.1.9", false},
@@ -508,6 +580,382 @@ func TestParseRange(t *testing.T) {
 	}
 }
 
+func TestParseRangeInvalidHyphen(t *testing.T) {
+	for _, s := range []string{"1.2.3 -", "- 1.2.3", ">1.2.3 - 2.0.0", "1.2.3 - <2.0.0", "1.2.3 - - 2.0.0"} {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("Expected error for case %q", s)
+		}
+	}
+}
+
+func TestParseRangeCaretTilde(t *testing.T) {
+	type tv struct {
+		v string
+		b bool
+	}
+	tests := []struct {
+		i string
+		t []tv
+	}{
+		{"^1.2.3", []tv{
+			{"1.2.2", false},
+			{"1.2.3", true},
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{"^ 0.2.3", []tv{
+			{"0.2.2", false},
+			{"0.2.9", true},
+			{"0.3.0", false},
+		}},
+		{"^1.2", []tv{
+			{"1.1.9", false},
+			{"1.2.0", true},
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{"^0", []tv{
+			{"0.9.9", true},
+			{"1.0.0", false},
+		}},
+		{"~1.2.3", []tv{
+			{"1.2.2", false},
+			{"1.2.9", true},
+			{"1.3.0", false},
+		}},
+		{"~ 1.2", []tv{
+			{"1.2.0", true},
+			{"1.2.9", true},
+			{"1.3.0", false},
+		}},
+		{"~1", []tv{
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{"^1.2.3-rc.1, !=1.5.0 || ~3.0.1", []tv{
+			{"1.2.3-rc.1", true},
+			{"1.4.0", true},
+			{"1.5.0", false},
+			{"3.0.5", true},
+			{"3.1.0", false},
+		}},
+	}
+
+	for _, tc := range tests {
+		r, err := ParseRange(tc.i)
+		if err != nil {
+			t.Errorf("Error parsing range %q: %s", tc.i, err)
+			continue
+		}
+
+		for _, tvc := range tc.t {
+			if res := r(MustParse(tvc.v)); res != tvc.b {
+				t.Errorf("Invalid for case %q matching %q: Expected %t, got: %t", tc.i, tvc.v, tvc.b, res)
+			}
+		}
+	}
+
+	for _, s := range []string{"^", "~", "^v1.2.3", "~1.2.x", "^1.2-rc", "^^1.2.3", "~~1.2.3"} {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("Expected error for case %q", s)
+		}
+	}
+}
+
+func TestParseRangePessimistic(t *testing.T) {
+	type tv struct {
+		v string
+		b bool
+	}
+	tests := []struct {
+		i string
+		t []tv
+	}{
+		{"~> 1.2", []tv{
+			{"1.1.9", false},
+			{"1.2.0", true},
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{"~>1.2.3", []tv{
+			{"1.2.2", false},
+			{"1.2.3", true},
+			{"1.2.9", true},
+			{"1.3.0", false},
+			{"1.9.9", false},
+		}},
+		{"~> 1", []tv{
+			{"0.9.9", false},
+			{"1.0.0", true},
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{"~> 1.2.3-rc.1", []tv{
+			{"1.2.3-beta", false},
+			{"1.2.3-rc.1", true},
+			{"1.2.4", true},
+			{"1.3.0", false},
+		}},
+		{"~> 1.18446744073709551615.2", []tv{
+			{"1.18446744073709551615.9", true},
+			{"2.0.0", false},
+		}},
+		{"~> 18446744073709551615.2", []tv{
+			{"18446744073709551615.1.0", false},
+			{"18446744073709551615.18446744073709551615.0", true},
+		}},
+		{"~> 1.2, !=1.5.0 || ~> 3.0.1", []tv{
+			{"1.4.0", true},
+			{"1.5.0", false},
+			{"3.0.5", true},
+			{"3.1.0", false},
+		}},
+	}
+
+	for _, tc := range tests {
+		r, err := ParseRange(tc.i)
+		if err != nil {
+			t.Errorf("Error parsing range %q: %s", tc.i, err)
+			continue
+		}
+
+		for _, tvc := range tc.t {
+			if res := r(MustParse(tvc.v)); res != tvc.b {
+				t.Errorf("Invalid for case %q matching %q: Expected %t, got: %t", tc.i, tvc.v, tvc.b, res)
+			}
+		}
+	}
+
+	for _, s := range []string{"~>", "~> v1.2", "~> 1.2.x", "~> 1.2-rc", "~> 1.2.3.4"} {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("Expected error for case %q", s)
+		}
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	type tv struct {
+		v string
+		b bool
+	}
+	const max = ^uint64(0)
+	tests := []struct {
+		v      Version
+		policy RangePolicy
+		r      string
+		t      []tv
+	}{
+		{Version{1, 2, 3, nil, nil}, RangeExact, "=1.2.3", []tv{
+			{"1.2.3", true},
+			{"1.2.4", false},
+		}},
+		{Version{1, 2, 3, nil, nil}, RangeCaret, "^1.2.3", []tv{
+			{"1.2.2", false},
+			{"1.2.3", true},
+			{"1.9.0", true},
+			{"2.0.0", false},
+		}},
+		{Version{0, 2, 3, nil, nil}, RangeCaret, "^0.2.3", []tv{
+			{"0.2.3", true},
+			{"0.2.9", true},
+			{"0.3.0", false},
+		}},
+		{Version{1, 2, 3, nil, nil}, RangeTilde, "~1.2.3", []tv{
+			{"1.2.2", false},
+			{"1.2.9", true},
+			{"1.3.0", false},
+		}},
+		{Version{1, 2, 3, nil, nil}, RangeMinorPin, ">=1.2.3, <1.3.0", []tv{
+			{"1.2.2", false},
+			{"1.2.9", true},
+			{"1.3.0", false},
+		}},
+		{Version{1, max, 3, nil, nil}, RangeMinorPin, ">=1.18446744073709551615.3, <2.0.0", []tv{
+			{"1.18446744073709551615.9", true},
+			{"2.0.0", false},
+		}},
+		{Version{0, max, 3, nil, nil}, RangeCaret, "^0.18446744073709551615.3", []tv{
+			{"0.18446744073709551615.9", true},
+			{"1.0.0", false},
+		}},
+		{Version{max, 2, 3, nil, nil}, RangeCaret, "^18446744073709551615.2.3", []tv{
+			{"18446744073709551615.2.2", false},
+			{"18446744073709551615.9.0", true},
+		}},
+		{Version{max, max, 3, nil, nil}, RangeTilde, "~18446744073709551615.18446744073709551615.3", []tv{
+			{"18446744073709551615.18446744073709551615.2", false},
+			{"18446744073709551615.18446744073709551615.9", true},
+		}},
+	}
+
+	for _, tc := range tests {
+		r := tc.v.RangeString(tc.policy)
+		if r != tc.r {
+			t.Errorf("Invalid for %q policy %d: Expected %q, got: %q", tc.v, tc.policy, tc.r, r)
+		}
+
+		rf, err := ParseRange(r)
+		if err != nil {
+			t.Errorf("Error parsing range %q: %s", r, err)
+			continue
+		}
+
+		for _, tvc := range tc.t {
+			if res := rf(MustParse(tvc.v)); res != tvc.b {
+				t.Errorf("Invalid for case %q matching %q: Expected %t, got: %t", r, tvc.v, tvc.b, res)
+			}
+		}
+	}
+}
+
+func TestParseExactRange(t *testing.T) {
+	tests := []struct {
+		v string
+		b bool
+	}{
+		{"1.2.3", true},
+		{"1.3.0", true},
+		{"2.0.0-rc.1", true},
+		{"1.2.3+build", true},
+		{"1.2.4", false},
+		{"2.0.0", false},
+	}
+
+	r, err := ParseExactRange("1.2.3", "1.3.0", "2.0.0-rc.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for _, tc := range tests {
+		if res := r(MustParse(tc.v)); res != tc.b {
+			t.Errorf("Invalid for case %q: Expected %t, got: %t", tc.v, tc.b, res)
+		}
+	}
+
+	if _, err = ParseExactRange("1.2.3", "1.2"); err == nil {
+		t.Errorf("Expected error for invalid version")
+	}
+	if _, err = ParseExactRange(); err == nil {
+		t.Errorf("Expected error for empty version list")
+	}
+}
+
+func TestValidateRange(t *testing.T) {
+	valid := []string{
+		">=1.2.3 <2.0.0",
+		">=1.2.3, <2.0.0",
+		"1.2.x",
+		"1.2.3 - 2.3.4",
+		"<2.0.0 || >=3.0.0",
+		"!=1.2.3",
+	}
+	for _, s := range valid {
+		if err := ValidateRange(s); err != nil {
+			t.Errorf("Unexpected error for case %q: %s", s, err)
+		}
+		if !IsValidRange(s) {
+			t.Errorf("Expected %q to be valid", s)
+		}
+	}
+
+	invalid := []string{
+		"",
+		">=1.2",
+		"1.2.3 -",
+		"<2.0.0 ||",
+		">>1.2.3",
+		"invalid version",
+	}
+	for _, s := range invalid {
+		if err := ValidateRange(s); err == nil {
+			t.Errorf("Expected error for case %q", s)
+		}
+		if IsValidRange(s) {
+			t.Errorf("Expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestCommonCaretRange(t *testing.T) {
+	type tv struct {
+		v string
+		b bool
+	}
+	tests := []struct {
+		a, b string
+		t    []tv
+	}{
+		{"1.2.0", "1.5.0", []tv{
+			{"1.2.0", false},
+			{"1.4.9", false},
+			{"1.5.0", true},
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{"1.5.0", "1.2.0", []tv{
+			{"1.4.9", false},
+			{"1.5.0", true},
+			{"2.0.0-rc.1", true},
+			{"2.0.0", false},
+		}},
+		{"0.2.1", "0.2.5", []tv{
+			{"0.2.4", false},
+			{"0.2.5", true},
+			{"0.2.9", true},
+			{"0.3.0", false},
+		}},
+		{"18446744073709551615.1.0", "18446744073709551615.2.0", []tv{
+			{"18446744073709551615.1.9", false},
+			{"18446744073709551615.2.0", true},
+			{"18446744073709551615.18446744073709551615.0", true},
+		}},
+		{"0.18446744073709551615.0", "0.18446744073709551615.2", []tv{
+			{"0.18446744073709551615.2", true},
+			{"1.0.0", false},
+		}},
+	}
+
+	for _, tc := range tests {
+		r, ok := CommonCaretRange(MustParse(tc.a), MustParse(tc.b))
+		if !ok {
+			t.Errorf("Expected common range for %q and %q", tc.a, tc.b)
+			continue
+		}
+
+		for _, tvc := range tc.t {
+			if res := r(MustParse(tvc.v)); res != tvc.b {
+				t.Errorf("Invalid for %q and %q matching %q: Expected %t, got: %t", tc.a, tc.b, tvc.v, tvc.b, res)
+			}
+		}
+	}
+
+	for _, tc := range [][2]string{{"1.2.0", "2.0.0"}, {"0.2.0", "0.3.0"}, {"0.9.0", "1.0.0"}} {
+		if _, ok := CommonCaretRange(MustParse(tc[0]), MustParse(tc[1])); ok {
+			t.Errorf("Expected no common range for %q and %q", tc[0], tc[1])
+		}
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		v string
+		b bool
+	}{
+		{"1.2.3", true},
+		{"1.9.9", true},
+		{"1.2.2", false},
+		{"2.0.0", false},
+		{"3.0.0", true},
+		{"4.2.1", false},
+	}
+
+	r := MustParseRange(">1.2.2 <2.0.0 || >=3.0.0 !4.2.1")
+	for _, tc := range tests {
+		if res := MustParse(tc.v).Satisfies(r); res != tc.b {
+			t.Errorf("Invalid for case %q: Expected %t, got: %t", tc.v, tc.b, res)
+		}
+	}
+}
+
 func TestMustParseRange(t *testing.T) {
 	testCase := ">1.2.2 <1.2.4 || >=2.0.0 <3.0.0"
 	r := MustParseRange(testCase)
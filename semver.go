@@ -3,6 +3,8 @@ package semver
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"strconv"
 	"strings"
 )
@@ -11,6 +13,12 @@ const (
 	numbers  string = "0123456789"
 	alphas          = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-"
 	alphanum        = alphas + numbers
+
+	// ordinalBits is amount of bits per version component packed by Ordinal
+	ordinalBits = 20
+
+	// maxReadSize limits amount of bytes ReadVersion consumes from reader
+	maxReadSize = 1024
 )
 
 // SpecVersion is the latest fully supported spec version of semver
@@ -18,6 +26,32 @@ var (
 	SpecVersion = Version{2, 0, 0, nil, nil}
 
 	ErrOutOfBound = errors.New("semver: out-of-bound")
+
+	// ErrEmptyVersion returned when version string is empty or contains only whitespace
+	ErrEmptyVersion = errors.New("semver: version string empty")
+
+	// ErrInvalidPrerelease returned (wrapped) when prerelease version is empty or contains invalid characters
+	ErrInvalidPrerelease = errors.New("semver: invalid prerelease")
+
+	// ErrBuildNotAllowed returned by ParseNoBuild when version carries build metadata
+	ErrBuildNotAllowed = errors.New("semver: build metadata not allowed")
+
+	// ErrVPrefixNotAllowed returned by ParseNoVPrefix when version has "v" prefix
+	ErrVPrefixNotAllowed = errors.New("semver: \"v\" prefix not allowed")
+)
+
+// Format describes form of the version string detected by ParseDetect
+type Format int
+
+const (
+	// FormatFullStrict version is accepted by Parse as is and has no build metadata
+	FormatFullStrict Format = iota
+	// FormatPartial version required normalization by ParseTolerant, e.g. "1.2" or "01.2.3"
+	FormatPartial
+	// FormatVPrefixed version has "v" prefix
+	FormatVPrefixed
+	// FormatWithMetadata version is accepted by Parse as is and carries build metadata
+	FormatWithMetadata
 )
 
 // PRVersion represents a PreRelease Version
@@ -54,10 +88,15 @@ func Make(s string) (Version, error) {
 
 // ParseTolerant allows for certain version specifications that do not strictly adhere to semver
 // specs to be parsed by this library. It does so by normalizing versions before passing them to
-// Parse(). It currently trims spaces, removes a "v" prefix, adds a 0 patch number to versions
-// with only major and minor components specified, and removes leading 0s.
+// Parse(). It currently trims spaces, removes a single "=" prefix (as in "=v1.2.3"), removes a "v" prefix,
+// adds a 0 patch number to versions with only major and minor components specified, and removes leading 0s.
 func ParseTolerant(s string) (Version, error) {
 	s = strings.TrimSpace(s)
+	if s == "" {
+		return Version{}, ErrEmptyVersion
+	}
+
+	s = strings.TrimPrefix(s, "=")
 	s = strings.TrimPrefix(s, "v")
 	s = strings.TrimPrefix(s, "V")
 
@@ -87,10 +126,48 @@ func ParseTolerant(s string) (Version, error) {
 	return Parse(s)
 }
 
+// ReadVersion reads version from r (e.g. VERSION file), trims surrounding whitespace
+// and parses it with ParseTolerant. Inputs larger than 1KB are rejected.
+func ReadVersion(r io.Reader) (Version, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxReadSize+1))
+	if err != nil {
+		return Version{}, err
+	}
+
+	if len(data) > maxReadSize {
+		return Version{}, fmt.Errorf("semver: version input exceeds %d bytes", maxReadSize)
+	}
+
+	return ParseTolerant(string(data))
+}
+
+// ParseDetect parses version with ParseTolerant and reports form of the input.
+// If several forms apply FormatVPrefixed takes precedence over FormatPartial
+// and FormatPartial over FormatWithMetadata
+func ParseDetect(s string) (Version, Format, error) {
+	v, err := ParseTolerant(s)
+	if err != nil {
+		return Version{}, FormatFullStrict, err
+	}
+
+	trimmed := strings.TrimPrefix(strings.TrimSpace(s), "=")
+
+	switch {
+	case strings.HasPrefix(trimmed, "v") || strings.HasPrefix(trimmed, "V"):
+		return v, FormatVPrefixed, nil
+	case v.String() != s:
+		return v, FormatPartial, nil
+	case len(v.build) != 0:
+		return v, FormatWithMetadata, nil
+	default:
+		return v, FormatFullStrict, nil
+	}
+}
+
 // Parse parses version string and returns a validated Version or error
 func Parse(s string) (Version, error) {
-	if len(s) == 0 {
-		return Version{}, errors.New("semver: version string empty")
+	if strings.TrimSpace(s) == "" {
+		return Version{}, ErrEmptyVersion
 	}
 
 	var err error
@@ -180,6 +257,24 @@ func Parse(s string) (Version, error) {
 	return v, nil
 }
 
+// ParseNoBuild is like Parse but rejects versions carrying build metadata with ErrBuildNotAllowed
+func ParseNoBuild(s string) (Version, error) {
+	return NewParser(Options{ForbidBuild: true}).Parse(s)
+}
+
+// ParseNoVPrefix is like Parse but rejects versions with "v" prefix with ErrVPrefixNotAllowed
+func ParseNoVPrefix(s string) (Version, error) {
+	return NewParser(Options{ForbidVPrefix: true}).Parse(s)
+}
+
+// IsCanonical checks if s is a valid version in canonical form,
+// i.e. equal to String() of parsed version: no "v" prefix, all three components, no leading zeroes
+func IsCanonical(s string) bool {
+	v, err := Parse(s)
+
+	return err == nil && v.String() == s
+}
+
 // MustParse is like Parse but panics if the version cannot be parsed.
 func MustParse(s string) Version {
 	v, err := Parse(s)
@@ -210,7 +305,7 @@ func NewPrerelease(s string) ([]PRVersion, error) {
 // NewPRVersion creates a new valid prerelease version
 func NewPRVersion(s string) (PRVersion, error) {
 	if len(s) == 0 {
-		return PRVersion{}, errors.New("prerelease is empty")
+		return PRVersion{}, fmt.Errorf("%w: prerelease is empty", ErrInvalidPrerelease)
 	}
 
 	v := PRVersion{}
@@ -230,7 +325,7 @@ func NewPRVersion(s string) (PRVersion, error) {
 		v.VersionStr = s
 		v.IsNum = false
 	} else {
-		return PRVersion{}, fmt.Errorf("invalid character(s) found in prerelease %q", s)
+		return PRVersion{}, invalidPrereleaseError(s)
 	}
 	return v, nil
 }
@@ -268,13 +363,84 @@ func NewBuildVersion(s string) (string, error) {
 
 // Version to string
 func (v Version) String() string {
-	b := make([]byte, 0, 5)
+	return string(v.appendTo(make([]byte, 0, 5)))
+}
+
+// appendTo appends string representation of v to b
+func (v Version) appendTo(b []byte) []byte {
 	b = strconv.AppendUint(b, v.major, 10)
 	b = append(b, '.')
 	b = strconv.AppendUint(b, v.minor, 10)
 	b = append(b, '.')
 	b = strconv.AppendUint(b, v.patch, 10)
 
+	for i, pre := range v.pre {
+		if i == 0 {
+			b = append(b, '-')
+		} else {
+			b = append(b, '.')
+		}
+
+		if pre.IsNum {
+			b = strconv.AppendUint(b, pre.VersionNum, 10)
+		} else {
+			b = append(b, pre.VersionStr...)
+		}
+	}
+
+	for i, build := range v.build {
+		if i == 0 {
+			b = append(b, '+')
+		} else {
+			b = append(b, '.')
+		}
+
+		b = append(b, build...)
+	}
+
+	return b
+}
+
+// Minimal returns compact version string with trailing zero patch and minor versions dropped,
+// e.g. "1.2" for "1.2.0" and "1" for "1.0.0". Versions with prerelease are always rendered
+// in full ("1.0.0-rc") since short form would not be a valid version
+func (v Version) Minimal() string {
+	if len(v.pre) != 0 {
+		return v.String()
+	}
+
+	b := make([]byte, 0, 5)
+	b = strconv.AppendUint(b, v.major, 10)
+
+	if v.minor != 0 || v.patch != 0 {
+		b = append(b, '.')
+		b = strconv.AppendUint(b, v.minor, 10)
+	}
+
+	if v.patch != 0 {
+		b = append(b, '.')
+		b = strconv.AppendUint(b, v.patch, 10)
+	}
+
+	if build := v.BuildString(); build != "" {
+		b = append(b, '+')
+		b = append(b, build...)
+	}
+
+	return string(b)
+}
+
+// PaddedString returns version string with major, minor and patch versions
+// zero-padded to width digits, e.g. "0001.0002.0003" for width 4.
+// Components longer than width are not truncated. Prerelease and build metadata are appended as is
+func (v Version) PaddedString(width int) string {
+	b := make([]byte, 0, 5)
+	b = appendPadded(b, v.major, width)
+	b = append(b, '.')
+	b = appendPadded(b, v.minor, width)
+	b = append(b, '.')
+	b = appendPadded(b, v.patch, width)
+
 	if pre := v.PrerelString(); pre != "" {
 		b = append(b, '-')
 		b = append(b, pre...)
@@ -288,6 +454,18 @@ func (v Version) String() string {
 	return string(b)
 }
 
+// DockerTag returns version string usable as docker image tag, which cannot contain "+":
+// build metadata separator is replaced with "_", e.g. "1.2.3-rc.1_build.5".
+// Result is not guaranteed to be parsable back into the same version
+func (v Version) DockerTag() string {
+	return strings.Replace(v.String(), "+", "_", 1)
+}
+
+// GoString implements fmt.GoStringer, used by %#v
+func (v Version) GoString() string {
+	return "semver.MustParse(" + strconv.Quote(v.String()) + ")"
+}
+
 func (v Version) Major() uint64 {
 	return v.major
 }
@@ -300,6 +478,24 @@ func (v Version) Patch() uint64 {
 	return v.patch
 }
 
+// Segments returns major, minor and patch versions
+func (v Version) Segments() [3]uint64 {
+	return [3]uint64{v.major, v.minor, v.patch}
+}
+
+// Ordinal packs major, minor and patch versions into single integer, 20 bits each,
+// preserving their order. Prerelease and build metadata are ignored.
+// Returns false if any component does not fit into 20 bits
+func (v Version) Ordinal() (uint64, bool) {
+	const max = 1<<ordinalBits - 1
+
+	if v.major > max || v.minor > max || v.patch > max {
+		return 0, false
+	}
+
+	return v.major<<(2*ordinalBits) | v.minor<<ordinalBits | v.patch, true
+}
+
 func (v *Version) SetMajor(val uint64) {
 	v.major = val
 }
@@ -322,6 +518,26 @@ func (v *Version) SetBuild(val []string) {
 	copy(v.build, val)
 }
 
+// SetBuildStrict parses dot-separated build metadata s and sets it.
+// In addition to NewBuild checks numeric identifiers must not contain leading zeroes.
+// Empty s removes build metadata
+func (v *Version) SetBuildStrict(s string) error {
+	build, err := NewBuild(s)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range build {
+		if containsOnly(b, numbers) && hasLeadingZeroes(b) {
+			return fmt.Errorf("semver: numeric build metadata must not contain leading zeroes %q", b)
+		}
+	}
+
+	v.build = build
+
+	return nil
+}
+
 func (v Version) Prerel() []PRVersion {
 	res := make([]PRVersion, len(v.pre))
 	copy(res, v.pre)
@@ -362,6 +578,30 @@ func (v Version) BuildString() string {
 	return res
 }
 
+// Truncate returns copy of v reduced to given precision, dropping prerelease and build metadata:
+// parts 1 keeps major only ("1.0.0"), 2 keeps major and minor ("1.2.0"), 3 keeps major, minor and patch.
+// Values below 1 are treated as 1 and above 3 as 3
+func (v Version) Truncate(parts int) Version {
+	res := Version{major: v.major}
+
+	if parts >= 2 {
+		res.minor = v.minor
+	}
+
+	if parts >= 3 {
+		res.patch = v.patch
+	}
+
+	return res
+}
+
+// Redact returns copy of v with build metadata removed and prerelease kept,
+// e.g. "1.2.3-rc.1" for "1.2.3-rc.1+internal.host42"
+func (v Version) Redact() Version {
+	v.build = nil
+	return v
+}
+
 // IncrementPatch increments the patch version
 func (v *Version) IncrementPatch() error {
 	if v.patch == ^uint64(0) {
@@ -394,6 +634,49 @@ func (v *Version) IncrementMajor() error {
 	return nil
 }
 
+// IncrementPatchN increments the patch version by n
+func (v *Version) IncrementPatchN(n uint64) error {
+	if v.patch > ^uint64(0)-n {
+		return ErrOutOfBound
+	}
+
+	v.patch += n
+	return nil
+}
+
+// IncrementMinorN increments the minor version by n.
+// Patch version is reset unless n is 0
+func (v *Version) IncrementMinorN(n uint64) error {
+	if v.minor > ^uint64(0)-n {
+		return ErrOutOfBound
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	v.minor += n
+	v.patch = 0
+	return nil
+}
+
+// IncrementMajorN increments the major version by n.
+// Minor and patch versions are reset unless n is 0
+func (v *Version) IncrementMajorN(n uint64) error {
+	if v.major > ^uint64(0)-n {
+		return ErrOutOfBound
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	v.major += n
+	v.minor = 0
+	v.patch = 0
+	return nil
+}
+
 // Validate validates v and returns error in case
 func (v Version) Validate() error {
 	// Major, Minor, Patch already validated using uint64
@@ -401,10 +684,10 @@ func (v Version) Validate() error {
 	for _, pre := range v.pre {
 		if !pre.IsNum { // Numeric prerelease versions already uint64
 			if len(pre.VersionStr) == 0 {
-				return fmt.Errorf("semver: prerelease cannot be empty %q", pre.VersionStr)
+				return fmt.Errorf("%w: prerelease cannot be empty", ErrInvalidPrerelease)
 			}
 			if !containsOnly(pre.VersionStr, alphanum) {
-				return fmt.Errorf("semver: invalid character(s) found in prerelease %q", pre.VersionStr)
+				return invalidPrereleaseError(pre.VersionStr)
 			}
 		}
 	}
@@ -435,12 +718,32 @@ func (v PRVersion) String() string {
 	return v.VersionStr
 }
 
+// invalidPrereleaseError wraps ErrInvalidPrerelease naming first invalid character of s and its byte offset
+func invalidPrereleaseError(s string) error {
+	for i, r := range s {
+		if !strings.ContainsRune(alphanum, r) {
+			return fmt.Errorf("%w: invalid character %q at offset %d in %q", ErrInvalidPrerelease, r, i, s)
+		}
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidPrerelease, s)
+}
+
 func containsOnly(s string, set string) bool {
 	return strings.IndexFunc(s, func(r rune) bool {
 		return !strings.ContainsRune(set, r)
 	}) == -1
 }
 
+func appendPadded(b []byte, val uint64, width int) []byte {
+	num := strconv.FormatUint(val, 10)
+	for i := len(num); i < width; i++ {
+		b = append(b, '0')
+	}
+
+	return append(b, num...)
+}
+
 func hasLeadingZeroes(s string) bool {
 	return len(s) > 1 && s[0] == '0'
 }
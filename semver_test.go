@@ -1,6 +1,9 @@
 package semver
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -45,10 +48,14 @@ var tolerantFormatTests = []formatTestTolerant{
 	{Version{1, 2, 0, nil, nil}, "1.2.00", "1.2.0"},
 	{Version{1, 2, 3, nil, nil}, "	1.2.3 ", "1.2.3"},
 	{Version{1, 2, 3, nil, nil}, "01.02.03", "1.2.3"},
+	{Version{1, 2, 3, nil, nil}, "1.02.3", "1.2.3"},
+	{Version{1, 2, 3, []PRVersion{prstr("beta")}, nil}, "1.2.03-beta", "1.2.3-beta"},
 	{Version{0, 0, 3, nil, nil}, "00.0.03", "0.0.3"},
 	{Version{0, 0, 3, nil, nil}, "000.0.03", "0.0.3"},
 	{Version{1, 2, 0, nil, nil}, "1.2", "1.2.0"},
 	{Version{1, 0, 0, nil, nil}, "1", "1.0.0"},
+	{Version{1, 2, 3, nil, nil}, "=1.2.3", "1.2.3"},
+	{Version{1, 2, 3, nil, nil}, "=v1.2.3", "1.2.3"},
 }
 
 func TestStringer(t *testing.T) {
@@ -58,6 +65,44 @@ func TestStringer(t *testing.T) {
 	}
 }
 
+func TestMinimal(t *testing.T) {
+	tests := []struct {
+		v        string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.2.0", "1.2"},
+		{"1.0.0", "1"},
+		{"0.0.0", "0"},
+		{"1.0.3", "1.0.3"},
+		{"1.0.0-rc", "1.0.0-rc"},
+		{"1.2.0+build", "1.2+build"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, MustParse(tc.v).Minimal())
+	}
+}
+
+func TestPaddedString(t *testing.T) {
+	require.Equal(t, "001.022.333", MustParse("1.22.333").PaddedString(3))
+	require.Equal(t, "0001.0002.0003-beta.1+build", MustParse("1.2.3-beta.1+build").PaddedString(4))
+	require.Equal(t, "1234.05.06", MustParse("1234.5.6").PaddedString(2))
+	require.Equal(t, "1.2.3", MustParse("1.2.3").PaddedString(0))
+	require.Equal(t, "1.2.3", MustParse("1.2.3").PaddedString(-1))
+}
+
+func TestDockerTag(t *testing.T) {
+	require.Equal(t, "1.2.3-rc.1_build.5", MustParse("1.2.3-rc.1+build.5").DockerTag())
+	require.Equal(t, "1.2.3", MustParse("1.2.3").DockerTag())
+}
+
+func TestGoString(t *testing.T) {
+	v := MustParse("1.2.3-beta.1+build")
+	require.Equal(t, `semver.MustParse("1.2.3-beta.1+build")`, v.GoString())
+	require.Equal(t, `semver.MustParse("1.2.3-beta.1+build")`, fmt.Sprintf("%#v", v))
+}
+
 func TestParse(t *testing.T) {
 	for _, test := range formatTests {
 		v, err := Parse(test.result)
@@ -86,6 +131,92 @@ func TestParseTolerant(t *testing.T) {
 	}
 }
 
+func TestParseEmpty(t *testing.T) {
+	for _, s := range []string{"", " ", "\n", " \t\r\n"} {
+		_, err := Parse(s)
+		require.Equal(t, ErrEmptyVersion, err, "%q", s)
+
+		_, err = ParseTolerant(s)
+		require.Equal(t, ErrEmptyVersion, err, "%q", s)
+	}
+
+	_, err := Parse("1.2")
+	require.Error(t, err)
+	require.NotEqual(t, ErrEmptyVersion, err)
+}
+
+func TestReadVersion(t *testing.T) {
+	v, err := ReadVersion(strings.NewReader("1.2.3-beta.1\n"))
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-beta.1", v.String())
+
+	_, err = ReadVersion(strings.NewReader(strings.Repeat("1", maxReadSize+1)))
+	require.Error(t, err)
+
+	_, err = ReadVersion(strings.NewReader("invalid\n"))
+	require.Error(t, err)
+}
+
+func TestParseNoBuild(t *testing.T) {
+	v, err := ParseNoBuild("1.2.3")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", v.String())
+
+	_, err = ParseNoBuild("1.2.3+foo")
+	require.Equal(t, ErrBuildNotAllowed, err)
+
+	_, err = ParseNoBuild("1.2")
+	require.Error(t, err)
+}
+
+func TestParseNoVPrefix(t *testing.T) {
+	v, err := ParseNoVPrefix("1.2.3")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", v.String())
+
+	_, err = ParseNoVPrefix("v1.2.3")
+	require.Equal(t, ErrVPrefixNotAllowed, err)
+
+	_, err = ParseNoVPrefix("V1.2.3")
+	require.Equal(t, ErrVPrefixNotAllowed, err)
+}
+
+func TestParseDetect(t *testing.T) {
+	tests := []struct {
+		s      string
+		format Format
+	}{
+		{"1.2.3", FormatFullStrict},
+		{"1.2.3-beta.1", FormatFullStrict},
+		{"1.2", FormatPartial},
+		{"01.2.3", FormatPartial},
+		{" 1.2.3", FormatPartial},
+		{"v1.2.3", FormatVPrefixed},
+		{"v1.2", FormatVPrefixed},
+		{"1.2.3+build", FormatWithMetadata},
+	}
+
+	for _, tc := range tests {
+		v, format, err := ParseDetect(tc.s)
+		require.NoError(t, err, tc.s)
+		require.Equal(t, tc.format, format, tc.s)
+		require.NoError(t, v.Validate())
+	}
+
+	_, _, err := ParseDetect("garbage")
+	require.Error(t, err)
+}
+
+func TestIsCanonical(t *testing.T) {
+	for _, s := range []string{"1.2.3", "0.0.0", "1.2.3-beta.1+build.5"} {
+		require.True(t, IsCanonical(s), s)
+	}
+
+	for _, s := range []string{"v1.2.3", "V1.2.3", "1.2", "01.2.3", " 1.2.3", "=1.2.3", ""} {
+		require.False(t, IsCanonical(s), s)
+	}
+}
+
 func TestMustParse(t *testing.T) {
 	require.NotPanics(t, func() {
 		_ = MustParse("32.2.1-alpha")
@@ -153,6 +284,137 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+func TestCompareOpts(t *testing.T) {
+	a := MustParse("1.2.3+build.1")
+	b := MustParse("1.2.3+build.2")
+
+	require.Equal(t, 0, a.CompareOpts(b, CompareOptions{}))
+	require.Equal(t, -1, a.CompareOpts(b, CompareOptions{MetadataTiebreak: true}))
+	require.Equal(t, 1, b.CompareOpts(a, CompareOptions{MetadataTiebreak: true}))
+	require.Equal(t, 1, a.CompareOpts(MustParse("1.2.3"), CompareOptions{MetadataTiebreak: true}))
+	require.Equal(t, -1, b.CompareOpts(MustParse("1.2.4"), CompareOptions{MetadataTiebreak: true}))
+}
+
+func TestOrder(t *testing.T) {
+	require.Equal(t, OrderLess, MustParse("1.0.0-alpha").Order(MustParse("1.0.0")))
+	require.Equal(t, OrderEqual, MustParse("1.0.0+build").Order(MustParse("1.0.0")))
+	require.Equal(t, OrderGreater, MustParse("1.2.0").Order(MustParse("1.1.9")))
+}
+
+func TestCompareString(t *testing.T) {
+	v := MustParse("1.2.3")
+
+	res, err := v.CompareString("1.5.0")
+	require.NoError(t, err)
+	require.Equal(t, -1, res)
+
+	_, err = v.CompareString("1.5")
+	require.Error(t, err)
+}
+
+func TestCompareWithMetadataDate(t *testing.T) {
+	const layout = "20060102"
+
+	tests := []struct {
+		v1, v2   string
+		expected int
+	}{
+		{"1.2.3+20240116", "1.2.3+20240115", 1},
+		{"1.2.3+20240115", "1.2.3+20240116", -1},
+		{"1.2.3+20240115", "1.2.3+20240115", 0},
+		{"1.2.3+20240116", "1.2.4+20240115", -1},
+		{"1.2.3-rc.1+20240116", "1.2.3+20240115", -1},
+		// lexical fallback
+		{"1.2.3+nightly", "1.2.3+20240115", 1},
+		{"1.2.3", "1.2.3+20240115", -1},
+		{"1.2.3+abc", "1.2.3+abd", -1},
+	}
+
+	for _, tc := range tests {
+		res := MustParse(tc.v1).CompareWithMetadataDate(MustParse(tc.v2), layout)
+		require.Equal(t, tc.expected, res, "%s vs %s", tc.v1, tc.v2)
+	}
+}
+
+func TestCompareStrings(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.2.3", "1.2.3", 0},
+		{"v1.2", "1.2.1", -1},
+		{"2.0.0-rc.1", "v1.9.9", 1},
+		{" 1.0.0 ", "1.0.0-beta", 1},
+	}
+
+	for _, tc := range tests {
+		res, err := CompareStrings(tc.a, tc.b)
+		require.NoError(t, err, "%s vs %s", tc.a, tc.b)
+		require.Equal(t, tc.expected, res, "%s vs %s", tc.a, tc.b)
+	}
+
+	_, err := CompareStrings("1.2.3", "invalid")
+	require.Error(t, err)
+
+	_, err = CompareStrings("1.2.x", "1.2.3")
+	require.Error(t, err)
+}
+
+func TestDistance(t *testing.T) {
+	major, minor, patch := MustParse("1.2.3").Distance(MustParse("3.0.1-beta"))
+	require.Equal(t, int64(2), major)
+	require.Equal(t, int64(-2), minor)
+	require.Equal(t, int64(-2), patch)
+
+	major, minor, patch = MustParse("1.2.3").Distance(MustParse("1.2.3"))
+	require.Equal(t, int64(0), major)
+	require.Equal(t, int64(0), minor)
+	require.Equal(t, int64(0), patch)
+}
+
+func TestSameRelease(t *testing.T) {
+	require.True(t, MustParse("1.2.3-rc.2").SameRelease(MustParse("1.2.3")))
+	require.True(t, MustParse("1.2.3+build").SameRelease(MustParse("1.2.3-beta")))
+	require.False(t, MustParse("1.2.3").SameRelease(MustParse("1.2.4")))
+}
+
+func TestIsImmediateSuccessorOf(t *testing.T) {
+	o := MustParse("1.2.3")
+
+	require.True(t, MustParse("1.2.4").IsImmediateSuccessorOf(o))
+	require.True(t, MustParse("1.3.0").IsImmediateSuccessorOf(o))
+	require.True(t, MustParse("2.0.0").IsImmediateSuccessorOf(o))
+	require.False(t, MustParse("1.2.5").IsImmediateSuccessorOf(o))
+	require.False(t, MustParse("1.3.1").IsImmediateSuccessorOf(o))
+	require.False(t, MustParse("2.1.0").IsImmediateSuccessorOf(o))
+	require.False(t, MustParse("1.2.3").IsImmediateSuccessorOf(o))
+	require.False(t, MustParse("1.2.2").IsImmediateSuccessorOf(o))
+	require.False(t, MustParse("1.2.0").IsImmediateSuccessorOf(Version{1, 2, ^uint64(0), nil, nil}))
+}
+
+func TestIsDowngradeFrom(t *testing.T) {
+	require.True(t, MustParse("1.2.2").IsDowngradeFrom(MustParse("1.2.3")))
+	require.True(t, MustParse("1.2.3-rc1").IsDowngradeFrom(MustParse("1.2.3")))
+	require.False(t, MustParse("1.2.3").IsDowngradeFrom(MustParse("1.2.3-rc1")))
+	require.False(t, MustParse("1.2.3+build").IsDowngradeFrom(MustParse("1.2.3")))
+}
+
+func TestIsBreaking(t *testing.T) {
+	require.True(t, IsBreaking(MustParse("0.2.0"), MustParse("0.3.0")))
+	require.False(t, IsBreaking(MustParse("0.2.0"), MustParse("0.2.5")))
+	require.False(t, IsBreaking(MustParse("1.2.0"), MustParse("1.3.0")))
+	require.True(t, IsBreaking(MustParse("1.2.0"), MustParse("2.0.0")))
+}
+
+func TestIsCompatibleWith(t *testing.T) {
+	require.True(t, MustParse("1.2.0").IsCompatibleWith(MustParse("1.5.0")))
+	require.True(t, MustParse("1.2.0").IsCompatibleWith(MustParse("1.2.0")))
+	require.False(t, MustParse("1.2.0").IsCompatibleWith(MustParse("1.1.0")))
+	require.False(t, MustParse("1.2.0").IsCompatibleWith(MustParse("2.0.0")))
+	require.True(t, MustParse("0.2.0").IsCompatibleWith(MustParse("0.2.3")))
+	require.False(t, MustParse("0.2.0").IsCompatibleWith(MustParse("0.3.0")))
+}
+
 type wrongFormatTest struct {
 	v   *Version
 	str string
@@ -217,6 +479,9 @@ func TestWrongFormat(t *testing.T) {
 var wrongTolerantFormatTests = []wrongFormatTest{
 	{nil, "1.0+abc"},
 	{nil, "1.0-rc.1"},
+	{nil, "==1.2.3"},
+	{nil, "=="},
+	{nil, "v=1.2.3"},
 }
 
 func TestWrongTolerantFormat(t *testing.T) {
@@ -313,6 +578,49 @@ func TestOOBIncrements(t *testing.T) {
 	}
 }
 
+func TestIncrementsN(t *testing.T) {
+	v := MustParse("1.2.3")
+
+	require.NoError(t, v.IncrementPatchN(0))
+	require.Equal(t, "1.2.3", v.String())
+	require.NoError(t, v.IncrementMinorN(0))
+	require.Equal(t, "1.2.3", v.String())
+	require.NoError(t, v.IncrementMajorN(0))
+	require.Equal(t, "1.2.3", v.String())
+
+	require.NoError(t, v.IncrementPatchN(5))
+	require.Equal(t, "1.2.8", v.String())
+	require.NoError(t, v.IncrementMinorN(5))
+	require.Equal(t, "1.7.0", v.String())
+	require.NoError(t, v.IncrementMajorN(5))
+	require.Equal(t, "6.0.0", v.String())
+
+	v = Version{1, 2, ^uint64(0) - 1, nil, nil}
+	require.Equal(t, ErrOutOfBound, v.IncrementPatchN(2))
+	v = Version{1, ^uint64(0) - 1, 3, nil, nil}
+	require.Equal(t, ErrOutOfBound, v.IncrementMinorN(2))
+	v = Version{^uint64(0) - 1, 2, 3, nil, nil}
+	require.Equal(t, ErrOutOfBound, v.IncrementMajorN(2))
+}
+
+func TestTruncate(t *testing.T) {
+	v := MustParse("1.2.3-beta+build")
+
+	require.Equal(t, "1.0.0", v.Truncate(1).String())
+	require.Equal(t, "1.2.0", v.Truncate(2).String())
+	require.Equal(t, "1.2.3", v.Truncate(3).String())
+	require.Equal(t, "1.0.0", v.Truncate(0).String())
+	require.Equal(t, "1.2.3", v.Truncate(4).String())
+	require.Equal(t, "1.2.3-beta+build", v.String())
+}
+
+func TestRedact(t *testing.T) {
+	v := MustParse("1.2.3-rc.1+internal.host42")
+	require.Equal(t, "1.2.3-rc.1", v.Redact().String())
+	require.Equal(t, "1.2.3-rc.1+internal.host42", v.String())
+	require.Equal(t, "1.2.3", MustParse("1.2.3").Redact().String())
+}
+
 func TestSetGet(t *testing.T) {
 	var v Version
 
@@ -339,6 +647,38 @@ func TestSetGet(t *testing.T) {
 	require.Equal(t, []string{"456"}, v.Build())
 }
 
+func TestSetBuildStrict(t *testing.T) {
+	v := MustParse("1.2.3")
+
+	for _, b := range []string{".123", "a..b", "a.", "a.01", "**"} {
+		require.Error(t, v.SetBuildStrict(b), b)
+		require.Equal(t, "1.2.3", v.String())
+	}
+
+	require.NoError(t, v.SetBuildStrict("a.0.10"))
+	require.Equal(t, "1.2.3+a.0.10", v.String())
+
+	require.NoError(t, v.SetBuildStrict(""))
+	require.Equal(t, "1.2.3", v.String())
+}
+
+func TestSegments(t *testing.T) {
+	require.Equal(t, [3]uint64{1, 2, 3}, MustParse("1.2.3-beta").Segments())
+}
+
+func TestOrdinal(t *testing.T) {
+	o1, ok := MustParse("1.2.3").Ordinal()
+	require.True(t, ok)
+	require.Equal(t, uint64(1<<40|2<<20|3), o1)
+
+	o2, ok := MustParse("1.10.0").Ordinal()
+	require.True(t, ok)
+	require.True(t, o1 < o2)
+
+	_, ok = MustParse("1.2.1048576").Ordinal()
+	require.False(t, ok)
+}
+
 func TestPreReleaseVersions(t *testing.T) {
 	p, err := NewPRVersion("123")
 	require.NoError(t, err)
@@ -351,6 +691,28 @@ func TestPreReleaseVersions(t *testing.T) {
 	require.Equal(t, "alpha", p.VersionStr)
 }
 
+func TestInvalidPrereleaseDetail(t *testing.T) {
+	_, err := NewPRVersion("al☃pha")
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+	require.Contains(t, err.Error(), `'☃'`)
+	require.Contains(t, err.Error(), "offset 2")
+
+	_, err = Parse("1.2.3-beta.al☃pha")
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+
+	err = Version{1, 2, 3, []PRVersion{prstr("al☃pha")}, nil}.Validate()
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+	require.Contains(t, err.Error(), "offset 2")
+}
+
+func TestPrerelTyped(t *testing.T) {
+	pre := MustParse("1.2.3-beta.1.x").Prerel()
+	require.Equal(t, []PRVersion{prstr("beta"), prnum(1), prstr("x")}, pre)
+	require.False(t, pre[0].IsNumeric())
+	require.True(t, pre[1].IsNumeric())
+	require.Equal(t, uint64(1), pre[1].VersionNum)
+}
+
 func TestBuildMetaDataVersions(t *testing.T) {
 	_, err := NewBuildVersion("123")
 	require.NoError(t, err)
@@ -402,6 +764,23 @@ func TestNewPrerelease(t *testing.T) {
 	require.Equal(t, 2, len(pr))
 }
 
+func TestNewPrereleaseEmptySegments(t *testing.T) {
+	for _, s := range []string{"beta..1", "beta.", ".beta"} {
+		_, err := NewPrerelease(s)
+		require.True(t, errors.Is(err, ErrInvalidPrerelease), s)
+
+		_, err = MustParse("1.2.3").Builder().Prerelease(s).Build()
+		require.True(t, errors.Is(err, ErrInvalidPrerelease), s)
+	}
+
+	pr, err := NewPrerelease("beta.1")
+	require.NoError(t, err)
+	require.Equal(t, []PRVersion{prstr("beta"), prnum(1)}, pr)
+
+	err = Version{0, 0, 0, []PRVersion{prstr(""), prstr("alpha")}, nil}.Validate()
+	require.True(t, errors.Is(err, ErrInvalidPrerelease))
+}
+
 func TestNewBuild(t *testing.T) {
 	pr, err := NewBuild("")
 	require.NoError(t, err)
@@ -22,7 +22,38 @@ func (s Versions) Less(i, j int) bool {
 	return s[i].LT(s[j])
 }
 
+// Less checks if version a is less than version b,
+// suitable for sort.Slice and similar APIs taking less function
+func Less(a, b Version) bool {
+	return a.LT(b)
+}
+
 // Sort sorts a slice of versions
 func Sort(versions []Version) {
 	sort.Sort(Versions(versions))
 }
+
+// SortFunc sorts versions using less instead of semver precedence
+func (s Versions) SortFunc(less func(a, b Version) bool) {
+	sort.Slice(s, func(i, j int) bool {
+		return less(s[i], s[j])
+	})
+}
+
+// SortPrereleaseLast sorts versions ascending, placing prereleases after the stable release
+// with the same major, minor and patch versions, e.g. "1.2.3", "1.2.3-rc.1", "1.2.4".
+// Note this ordering does not follow semver precedence
+func (s Versions) SortPrereleaseLast() {
+	sort.SliceStable(s, func(i, j int) bool {
+		if comp := s[i].compareCore(s[j]); comp != 0 {
+			return comp < 0
+		}
+
+		iStable, jStable := len(s[i].pre) == 0, len(s[j].pre) == 0
+		if iStable != jStable {
+			return iStable
+		}
+
+		return s[i].LT(s[j])
+	})
+}
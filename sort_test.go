@@ -1,7 +1,9 @@
 package semver
 
 import (
+	"container/heap"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,6 +20,82 @@ func TestSort(t *testing.T) {
 	require.True(t, reflect.DeepEqual(versions, correct), "Sort returned wrong order: %s", versions)
 }
 
+func TestSortPrereleaseLast(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.4"),
+		MustParse("1.2.3-rc.2"),
+		MustParse("1.2.3"),
+		MustParse("1.0.0-beta"),
+		MustParse("1.2.3-rc.1"),
+		MustParse("1.0.0"),
+	}
+	versions.SortPrereleaseLast()
+
+	correct := Versions{
+		MustParse("1.0.0"),
+		MustParse("1.0.0-beta"),
+		MustParse("1.2.3"),
+		MustParse("1.2.3-rc.1"),
+		MustParse("1.2.3-rc.2"),
+		MustParse("1.2.4"),
+	}
+	require.Equal(t, correct, versions)
+}
+
+func TestSortFunc(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.3+a"),
+		MustParse("1.0.0"),
+		MustParse("1.2.3-rc.1+c"),
+		MustParse("1.2.3+b"),
+	}
+
+	// by major.minor.patch ascending then build metadata descending
+	versions.SortFunc(func(a, b Version) bool {
+		if a.Segments() != b.Segments() {
+			return a.Truncate(3).LT(b.Truncate(3))
+		}
+		return a.BuildString() > b.BuildString()
+	})
+
+	correct := Versions{
+		MustParse("1.0.0"),
+		MustParse("1.2.3-rc.1+c"),
+		MustParse("1.2.3+b"),
+		MustParse("1.2.3+a"),
+	}
+	require.Equal(t, correct, versions)
+}
+
+type versionHeap []Version
+
+func (h versionHeap) Len() int            { return len(h) }
+func (h versionHeap) Less(i, j int) bool  { return Less(h[i], h[j]) }
+func (h versionHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *versionHeap) Push(x interface{}) { *h = append(*h, x.(Version)) }
+func (h *versionHeap) Pop() interface{} {
+	old := *h
+	v := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return v
+}
+
+func TestLess(t *testing.T) {
+	require.True(t, Less(MustParse("1.0.0-rc.1"), MustParse("1.0.0")))
+	require.False(t, Less(MustParse("1.0.0"), MustParse("1.0.0+build")))
+
+	versions := []Version{MustParse("2.0.0"), MustParse("1.0.0"), MustParse("1.5.0")}
+	sort.Slice(versions, func(i, j int) bool {
+		return Less(versions[i], versions[j])
+	})
+	require.Equal(t, []Version{MustParse("1.0.0"), MustParse("1.5.0"), MustParse("2.0.0")}, versions)
+
+	h := &versionHeap{MustParse("2.0.0"), MustParse("0.1.0"), MustParse("1.0.0")}
+	heap.Init(h)
+	require.Equal(t, "0.1.0", heap.Pop(h).(Version).String())
+	require.Equal(t, "1.0.0", heap.Pop(h).(Version).String())
+}
+
 func BenchmarkSort(b *testing.B) {
 	v100, _ := Parse("1.0.0")
 	v010, _ := Parse("0.1.0")
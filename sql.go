@@ -7,7 +7,7 @@ import (
 )
 
 var _ sql.Scanner = (*Version)(nil)
-var _ driver.Value = (*Version)(nil)
+var _ driver.Valuer = Version{}
 
 // Scan implements the database/sql.Scanner interface.
 func (v *Version) Scan(src interface{}) error {
@@ -1,6 +1,7 @@
 package semver
 
 import (
+	"database/sql/driver"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,3 +42,11 @@ func TestScanInValid(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+func TestValueNonPointer(t *testing.T) {
+	var valuer driver.Valuer = MustParse("1.2.3-beta")
+
+	val, err := valuer.Value()
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-beta", val)
+}
@@ -0,0 +1,33 @@
+package semver
+
+import (
+	"encoding"
+)
+
+var _ encoding.TextMarshaler = (*Version)(nil)
+var _ encoding.TextUnmarshaler = (*Version)(nil)
+
+// AppendText appends textual representation of v to b, see encoding.TextAppender (go1.24).
+func (v Version) AppendText(b []byte) ([]byte, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	return v.appendTo(b), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Version) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *Version) UnmarshalText(data []byte) error {
+	var err error
+
+	if *v, err = Parse(string(data)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextMarshalValid(t *testing.T) {
+	for _, test := range formatTests {
+		text, err := test.v.MarshalText()
+		require.NoError(t, err)
+		require.Equal(t, test.result, string(text))
+	}
+}
+
+func TestTextMarshalInValid(t *testing.T) {
+	var v Version
+	v.SetBuild([]string{"?"})
+
+	_, err := v.MarshalText()
+	require.Error(t, err)
+
+	_, err = v.AppendText(nil)
+	require.Error(t, err)
+}
+
+func TestAppendText(t *testing.T) {
+	v := MustParse("3.1.4-alpha.1.5.9+build.2.6.5")
+
+	b, err := v.AppendText([]byte("version: "))
+	require.NoError(t, err)
+	require.Equal(t, "version: 3.1.4-alpha.1.5.9+build.2.6.5", string(b))
+}
+
+func TestTextUnmarshal(t *testing.T) {
+	var v Version
+	err := v.UnmarshalText([]byte("3.1.4-alpha.1.5.9+build.2.6.5"))
+	require.NoError(t, err)
+	require.Equal(t, "3.1.4-alpha.1.5.9+build.2.6.5", v.String())
+
+	err = v.UnmarshalText([]byte("3.1"))
+	require.Error(t, err)
+}
+
+func BenchmarkMarshalText(b *testing.B) {
+	v := MustParse("0.0.1-alpha.preview+123.456")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = v.MarshalText()
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	v := MustParse("0.0.1-alpha.preview+123.456")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		buf, _ = v.AppendText(buf[:0])
+	}
+}
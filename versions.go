@@ -0,0 +1,326 @@
+package semver
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ParseVersions parses every string in raw and returns all valid versions in input order.
+// Unlike failing on first error it collects one error per invalid input, each naming the offending string
+func ParseVersions(raw []string) (Versions, []error) {
+	var res Versions
+	var errs []error
+
+	for _, s := range raw {
+		v, err := Parse(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("semver: %q: %w", s, err))
+			continue
+		}
+
+		res = append(res, v)
+	}
+
+	return res, errs
+}
+
+// Canonicalize parses every string in raw with ParseTolerant and returns canonical forms of valid ones
+// in input order, along with one error per invalid input naming the offending string
+func Canonicalize(raw []string) ([]string, []error) {
+	var res []string
+	var errs []error
+
+	for _, s := range raw {
+		v, err := ParseTolerant(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("semver: %q: %w", s, err))
+			continue
+		}
+
+		res = append(res, v.String())
+	}
+
+	return res, errs
+}
+
+// LatestStable returns highest version in collection which has no prerelease part.
+// Returns false if collection contains no stable versions
+func (s Versions) LatestStable() (Version, bool) {
+	var res Version
+	found := false
+
+	for _, v := range s {
+		if len(v.pre) != 0 {
+			continue
+		}
+
+		if !found || v.GT(res) {
+			res = v
+			found = true
+		}
+	}
+
+	return res, found
+}
+
+// Median returns median version of the collection, for even length the lower-middle element.
+// Collection order is not changed. Returns false if collection is empty
+func (s Versions) Median() (Version, bool) {
+	return s.Percentile(50)
+}
+
+// Percentile returns version at p-th percentile (0 <= p <= 100) using nearest-rank method.
+// Collection order is not changed. Returns false if collection is empty or p out of range
+func (s Versions) Percentile(p float64) (Version, bool) {
+	if len(s) == 0 || math.IsNaN(p) || p < 0 || p > 100 {
+		return Version{}, false
+	}
+
+	sorted := s.Clone()
+	Sort(sorted)
+
+	// multiply first so integer percentiles are exact
+	idx := int(math.Ceil(p*float64(len(sorted))/100)) - 1
+	if idx < 0 {
+		idx = 0
+	}
+
+	return sorted[idx], true
+}
+
+// Partition splits collection into versions satisfying r and the rest, preserving input order
+func (s Versions) Partition(r Range) (matching, rest Versions) {
+	for _, v := range s {
+		if r(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+
+	return
+}
+
+// Map returns new collection with fn applied to each version in order.
+// Versions for which fn returns false are skipped
+func (s Versions) Map(fn func(Version) (Version, bool)) Versions {
+	var res Versions
+
+	for _, v := range s {
+		if m, ok := fn(v); ok {
+			res = append(res, m)
+		}
+	}
+
+	return res
+}
+
+// MinCompatible returns lowest version in collection compatible with required under caret rules,
+// see Version.IsCompatibleWith. Returns false if there is no such version
+func (s Versions) MinCompatible(required Version) (Version, bool) {
+	var res Version
+	found := false
+
+	for _, v := range s {
+		if !required.IsCompatibleWith(v) {
+			continue
+		}
+
+		if !found || v.LT(res) {
+			res = v
+			found = true
+		}
+	}
+
+	return res, found
+}
+
+// Predecessor returns highest version in collection which is less than v.
+// Collection is not required to be sorted. Returns false if there is no such version
+func (s Versions) Predecessor(v Version) (Version, bool) {
+	var res Version
+	found := false
+
+	for _, o := range s {
+		if o.LT(v) && (!found || o.GT(res)) {
+			res = o
+			found = true
+		}
+	}
+
+	return res, found
+}
+
+// Successor returns lowest version in collection which is greater than v.
+// Collection is not required to be sorted. Returns false if there is no such version
+func (s Versions) Successor(v Version) (Version, bool) {
+	var res Version
+	found := false
+
+	for _, o := range s {
+		if o.GT(v) && (!found || o.LT(res)) {
+			res = o
+			found = true
+		}
+	}
+
+	return res, found
+}
+
+// Clone returns a shallow copy of the collection, so it can be sorted without changing original order.
+// Prerelease and build metadata slices of copied versions are shared with the original,
+// Version methods never modify them in place
+func (s Versions) Clone() Versions {
+	if s == nil {
+		return nil
+	}
+
+	res := make(Versions, len(s))
+	copy(res, s)
+
+	return res
+}
+
+// ForEachSorted calls fn for every version in ascending order, or descending if reverse is set,
+// passing position in iteration order. Collection order is not changed
+func (s Versions) ForEachSorted(reverse bool, fn func(int, Version)) {
+	sorted := s.Clone()
+	sort.Sort(sorted)
+
+	for i := range sorted {
+		if reverse {
+			fn(i, sorted[len(sorted)-1-i])
+		} else {
+			fn(i, sorted[i])
+		}
+	}
+}
+
+// TopN returns n highest versions sorted descending without changing collection order.
+// If n exceeds collection length all versions are returned
+func (s Versions) TopN(n int) Versions {
+	if n <= 0 {
+		return nil
+	}
+
+	sorted := s.Clone()
+	sort.Sort(sort.Reverse(sorted))
+
+	if n < len(sorted) {
+		sorted = sorted[:n]
+	}
+
+	return sorted
+}
+
+// PatchGaps reports missing patch versions within each major.minor line, one entry per gap:
+// single missing version as is, e.g. "1.2.2" when collection contains "1.2.0", "1.2.1" and "1.2.3",
+// and several consecutive ones as inclusive hyphen range, e.g. "1.3.1 - 1.3.3" between "1.3.0" and "1.3.4".
+// Every entry can be passed to ParseRange. Prerelease and build metadata are ignored.
+// Result is sorted ascending
+func (s Versions) PatchGaps() []string {
+	sorted := s.Clone()
+	Sort(sorted)
+
+	var res []string
+
+	for i := 1; i < len(sorted); i++ {
+		prev, cur := sorted[i-1], sorted[i]
+		if prev.major != cur.major || prev.minor != cur.minor {
+			continue
+		}
+
+		if cur.patch-prev.patch < 2 {
+			continue
+		}
+
+		first := Version{major: cur.major, minor: cur.minor, patch: prev.patch + 1}
+		last := Version{major: cur.major, minor: cur.minor, patch: cur.patch - 1}
+
+		if first.patch == last.patch {
+			res = append(res, first.String())
+		} else {
+			res = append(res, first.String()+" - "+last.String())
+		}
+	}
+
+	return res
+}
+
+// FilterStable returns versions without prerelease, preserving order
+func (s Versions) FilterStable() Versions {
+	_, rest := s.Partition(isPrerelease)
+	return rest
+}
+
+// FilterPrerelease returns versions with prerelease, preserving order
+func (s Versions) FilterPrerelease() Versions {
+	matching, _ := s.Partition(isPrerelease)
+	return matching
+}
+
+// SameCore returns versions with major, minor and patch equal to v's regardless of
+// prerelease and build metadata, see Version.SameRelease. Order is preserved
+func (s Versions) SameCore(v Version) Versions {
+	matching, _ := s.Partition(v.SameRelease)
+	return matching
+}
+
+// Intersect returns versions present in both s and o, sorted ascending without duplicates.
+// Versions are matched by precedence (see Compare), so build metadata is ignored
+// and elements of s are returned
+func (s Versions) Intersect(o Versions) Versions {
+	a, b := sortedUnique(s), sortedUnique(o)
+
+	var res Versions
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch a[i].Compare(b[j]) {
+		case -1:
+			i++
+		case 1:
+			j++
+		default:
+			res = append(res, a[i])
+			i++
+			j++
+		}
+	}
+
+	return res
+}
+
+// Union returns versions present in either s or o, sorted ascending without duplicates.
+// Versions are matched by precedence (see Compare), so build metadata is ignored
+// and elements of s take priority over equal elements of o
+func (s Versions) Union(o Versions) Versions {
+	res := make(Versions, 0, len(s)+len(o))
+	res = append(res, s...)
+	res = append(res, o...)
+
+	return sortedUnique(res)
+}
+
+// sortedUnique returns sorted copy of s keeping first of equal versions
+func sortedUnique(s Versions) Versions {
+	res := s.Clone()
+	sort.Stable(res)
+
+	if len(res) == 0 {
+		return res
+	}
+
+	n := 1
+	for _, v := range res[1:] {
+		if v.Compare(res[n-1]) != 0 {
+			res[n] = v
+			n++
+		}
+	}
+
+	return res[:n]
+}
+
+func isPrerelease(v Version) bool {
+	return len(v.pre) != 0
+}
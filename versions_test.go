@@ -0,0 +1,318 @@
+package semver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersions(t *testing.T) {
+	versions, errs := ParseVersions([]string{"1.0.0", "bad", "2.0.0-rc.1", "1.2"})
+	require.Len(t, errs, 2)
+	require.Contains(t, errs[0].Error(), `"bad"`)
+	require.Contains(t, errs[1].Error(), `"1.2"`)
+	require.Equal(t, Versions{MustParse("1.0.0"), MustParse("2.0.0-rc.1")}, versions)
+}
+
+func TestCanonicalize(t *testing.T) {
+	res, errs := Canonicalize([]string{"v1.2", "garbage", "1.2.3"})
+	require.Equal(t, []string{"1.2.0", "1.2.3"}, res)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), `"garbage"`)
+}
+
+func TestLatestStable(t *testing.T) {
+	versions := Versions{
+		MustParse("1.0.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.5.0"),
+		MustParse("1.2.0"),
+	}
+
+	v, ok := versions.LatestStable()
+	require.True(t, ok)
+	require.Equal(t, "1.5.0", v.String())
+
+	_, ok = Versions{MustParse("1.0.0-alpha")}.LatestStable()
+	require.False(t, ok)
+}
+
+func TestMedian(t *testing.T) {
+	versions := Versions{MustParse("3.0.0"), MustParse("1.0.0"), MustParse("2.0.0")}
+
+	v, ok := versions.Median()
+	require.True(t, ok)
+	require.Equal(t, "2.0.0", v.String())
+	require.Equal(t, "3.0.0", versions[0].String(), "input order must be preserved")
+
+	versions = append(versions, MustParse("4.0.0"))
+	v, ok = versions.Median()
+	require.True(t, ok)
+	require.Equal(t, "2.0.0", v.String())
+
+	_, ok = Versions{}.Median()
+	require.False(t, ok)
+}
+
+func TestPercentile(t *testing.T) {
+	versions := Versions{MustParse("3.0.0"), MustParse("1.0.0"), MustParse("2.0.0"), MustParse("4.0.0")}
+
+	v, ok := versions.Percentile(0)
+	require.True(t, ok)
+	require.Equal(t, "1.0.0", v.String())
+
+	v, ok = versions.Percentile(100)
+	require.True(t, ok)
+	require.Equal(t, "4.0.0", v.String())
+
+	v, ok = versions.Percentile(75)
+	require.True(t, ok)
+	require.Equal(t, "3.0.0", v.String())
+
+	_, ok = versions.Percentile(101)
+	require.False(t, ok)
+
+	_, ok = versions.Percentile(math.NaN())
+	require.False(t, ok)
+
+	hundred := make(Versions, 100)
+	for i := range hundred {
+		hundred[i] = Version{patch: uint64(i)}
+	}
+
+	v, ok = hundred.Percentile(7)
+	require.True(t, ok)
+	require.Equal(t, "0.0.6", v.String())
+
+	for p := 1; p <= 100; p++ {
+		v, _ = hundred.Percentile(float64(p))
+		require.Equal(t, uint64(p-1), v.Patch(), "p=%d", p)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	versions := Versions{
+		MustParse("2.0.0"),
+		MustParse("1.5.0"),
+		MustParse("0.9.0"),
+		MustParse("1.0.0"),
+		MustParse("1.0.0-rc.1"),
+	}
+
+	matching, rest := versions.Partition(MustParseRange(">=1.0.0 <2.0.0"))
+	require.Equal(t, Versions{MustParse("1.5.0"), MustParse("1.0.0")}, matching)
+	require.Equal(t, Versions{MustParse("2.0.0"), MustParse("0.9.0"), MustParse("1.0.0-rc.1")}, rest)
+}
+
+func TestMap(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.3-rc.1"),
+		MustParse("1.3.0-beta+build"),
+		MustParse("2.0.0"),
+	}
+
+	cores := versions.Map(func(v Version) (Version, bool) {
+		return v.Truncate(3), true
+	})
+	require.Equal(t, Versions{MustParse("1.2.3"), MustParse("1.3.0"), MustParse("2.0.0")}, cores)
+
+	majors := versions.Map(func(v Version) (Version, bool) {
+		return v, v.Major() == 1
+	})
+	require.Equal(t, Versions{MustParse("1.2.3-rc.1"), MustParse("1.3.0-beta+build")}, majors)
+}
+
+func TestMinCompatible(t *testing.T) {
+	versions := Versions{
+		MustParse("1.5.0"),
+		MustParse("1.1.0"),
+		MustParse("2.0.0"),
+		MustParse("1.2.4"),
+		MustParse("1.3.0"),
+	}
+
+	v, ok := versions.MinCompatible(MustParse("1.2.0"))
+	require.True(t, ok)
+	require.Equal(t, "1.2.4", v.String())
+
+	_, ok = versions.MinCompatible(MustParse("1.6.0"))
+	require.False(t, ok)
+}
+
+func TestPredecessorSuccessor(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.0"),
+		MustParse("1.0.0"),
+		MustParse("2.0.0"),
+		MustParse("1.1.0"),
+	}
+
+	v, ok := versions.Predecessor(MustParse("1.2.0"))
+	require.True(t, ok)
+	require.Equal(t, "1.1.0", v.String())
+
+	v, ok = versions.Successor(MustParse("1.2.0"))
+	require.True(t, ok)
+	require.Equal(t, "2.0.0", v.String())
+
+	_, ok = versions.Predecessor(MustParse("1.0.0"))
+	require.False(t, ok)
+
+	_, ok = versions.Successor(MustParse("2.0.0"))
+	require.False(t, ok)
+}
+
+func TestClone(t *testing.T) {
+	versions := Versions{MustParse("2.0.0"), MustParse("1.0.0"), MustParse("1.5.0")}
+
+	clone := versions.Clone()
+	Sort(clone)
+
+	require.Equal(t, Versions{MustParse("1.0.0"), MustParse("1.5.0"), MustParse("2.0.0")}, clone)
+	require.Equal(t, Versions{MustParse("2.0.0"), MustParse("1.0.0"), MustParse("1.5.0")}, versions)
+	require.Nil(t, Versions(nil).Clone())
+}
+
+func TestTopN(t *testing.T) {
+	versions := Versions{
+		MustParse("1.0.0"),
+		MustParse("3.0.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("2.0.0"),
+		MustParse("0.1.0"),
+	}
+
+	require.Equal(t, Versions{MustParse("3.0.0"), MustParse("2.0.0")}, versions.TopN(2))
+	require.Equal(t, "1.0.0", versions[0].String(), "input order must be preserved")
+	require.Len(t, versions.TopN(10), 5)
+	require.Empty(t, versions.TopN(0))
+}
+
+func TestPatchGaps(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.3"),
+		MustParse("1.2.0"),
+		MustParse("1.2.1"),
+		MustParse("1.2.1-rc.1"),
+		MustParse("1.3.0"),
+		MustParse("1.3.4"),
+		MustParse("2.0.1"),
+	}
+
+	gaps := versions.PatchGaps()
+	require.Equal(t, []string{"1.2.2", "1.3.1 - 1.3.3"}, gaps)
+	require.Empty(t, Versions{MustParse("1.0.0"), MustParse("1.0.1")}.PatchGaps())
+
+	r := MustParseRange(gaps[1])
+	require.True(t, r(MustParse("1.3.1")))
+	require.True(t, r(MustParse("1.3.3")))
+	require.False(t, r(MustParse("1.3.4")))
+
+	large := Versions{
+		MustParse("1.2.18446744073709551615"),
+		MustParse("1.2.0"),
+	}
+	require.Equal(t, []string{"1.2.1 - 1.2.18446744073709551614"}, large.PatchGaps())
+}
+
+func TestFilterStablePrerelease(t *testing.T) {
+	versions := Versions{
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.0.0"),
+		MustParse("1.1.0-beta"),
+		MustParse("1.1.0+build"),
+	}
+
+	require.Equal(t, Versions{MustParse("1.0.0"), MustParse("1.1.0+build")}, versions.FilterStable())
+	require.Equal(t, Versions{MustParse("2.0.0-rc.1"), MustParse("1.1.0-beta")}, versions.FilterPrerelease())
+}
+
+func TestSameCore(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.3-rc.1"),
+		MustParse("1.2.4"),
+		MustParse("1.2.3"),
+		MustParse("0.1.2-3"),
+		MustParse("1.2.3+build"),
+		MustParse("2.2.3"),
+	}
+
+	expected := Versions{
+		MustParse("1.2.3-rc.1"),
+		MustParse("1.2.3"),
+		MustParse("1.2.3+build"),
+	}
+
+	require.Equal(t, expected, versions.SameCore(MustParse("1.2.3-beta")))
+	require.Empty(t, versions.SameCore(MustParse("3.0.0")))
+}
+
+func TestIntersectUnion(t *testing.T) {
+	a := Versions{
+		MustParse("1.2.0"),
+		MustParse("v1.0.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.0.0"),
+	}
+	b := Versions{
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.1.0"),
+		MustParse("1.0.0+build"),
+	}
+	disjoint := Versions{
+		MustParse("3.0.0"),
+		MustParse("0.1.0"),
+	}
+
+	require.Equal(t, Versions{MustParse("1.0.0"), MustParse("2.0.0-rc.1")}, a.Intersect(b))
+	require.Equal(t, Versions{MustParse("1.0.0+build"), MustParse("2.0.0-rc.1")}, b.Intersect(a))
+	require.Empty(t, a.Intersect(disjoint))
+	require.Empty(t, a.Intersect(nil))
+
+	expected := Versions{
+		MustParse("1.0.0"),
+		MustParse("1.1.0"),
+		MustParse("1.2.0"),
+		MustParse("2.0.0-rc.1"),
+	}
+	require.Equal(t, expected, a.Union(b))
+
+	expected = Versions{
+		MustParse("0.1.0"),
+		MustParse("1.0.0"),
+		MustParse("1.2.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("3.0.0"),
+	}
+	require.Equal(t, expected, a.Union(disjoint))
+
+	// inputs are left intact
+	require.Equal(t, MustParse("1.2.0"), a[0])
+}
+
+func TestForEachSorted(t *testing.T) {
+	versions := Versions{
+		MustParse("1.2.0"),
+		MustParse("0.1.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.0.0"),
+	}
+	original := versions.Clone()
+
+	var asc []string
+	versions.ForEachSorted(false, func(i int, v Version) {
+		require.Equal(t, len(asc), i)
+		asc = append(asc, v.String())
+	})
+	require.Equal(t, []string{"0.1.0", "1.0.0", "1.2.0", "2.0.0-rc.1"}, asc)
+	require.Equal(t, original, versions)
+
+	var desc []string
+	versions.ForEachSorted(true, func(i int, v Version) {
+		require.Equal(t, len(desc), i)
+		desc = append(desc, v.String())
+	})
+	require.Equal(t, []string{"2.0.0-rc.1", "1.2.0", "1.0.0", "0.1.0"}, desc)
+	require.Equal(t, original, versions)
+}